@@ -0,0 +1,77 @@
+package api
+
+import "math"
+
+// Index into a game's Books of the best price for each side of each market,
+// -1 when no book offers it
+type BestLines struct {
+	AwaySpread    int
+	HomeSpread    int
+	Over          int
+	Under         int
+	AwayMoneyLine int
+	HomeMoneyLine int
+	DrawMoneyLine int
+}
+
+// Finds the best number for a bettor on each side: the most points on a
+// spread, the lowest total for the over and highest for the under, and the
+// biggest moneyline payout. Ties on the number go to the better price.
+func FindBestLines(books []Odds) BestLines {
+	best := BestLines{-1, -1, -1, -1, -1, -1, -1}
+	for i, book := range books {
+		if book.HasSpread() {
+			best.AwaySpread = better(books, best.AwaySpread, i, func(o Odds) (float64, int) {
+				return o.AwaySpread, o.AwaySpreadOdds
+			})
+			best.HomeSpread = better(books, best.HomeSpread, i, func(o Odds) (float64, int) {
+				return o.HomeSpread, o.HomeSpreadOdds
+			})
+		}
+		if book.HasTotal() {
+			best.Over = better(books, best.Over, i, func(o Odds) (float64, int) {
+				return -o.Total, o.OverOdds
+			})
+			best.Under = better(books, best.Under, i, func(o Odds) (float64, int) {
+				return o.Total, o.UnderOdds
+			})
+		}
+		if book.AwayMoneyLine != 0 {
+			best.AwayMoneyLine = better(books, best.AwayMoneyLine, i, func(o Odds) (float64, int) {
+				return float64(o.AwayMoneyLine), 0
+			})
+		}
+		if book.HomeMoneyLine != 0 {
+			best.HomeMoneyLine = better(books, best.HomeMoneyLine, i, func(o Odds) (float64, int) {
+				return float64(o.HomeMoneyLine), 0
+			})
+		}
+		if book.DrawMoneyLine != 0 {
+			best.DrawMoneyLine = better(books, best.DrawMoneyLine, i, func(o Odds) (float64, int) {
+				return float64(o.DrawMoneyLine), 0
+			})
+		}
+	}
+	return best
+}
+
+// Returns whichever of the current best and candidate scores higher on
+// line, then on price. American prices grow with the payout on both sides
+// of even, so a plain comparison works for them; a missing price loses.
+func better(books []Odds, current, candidate int, score func(Odds) (float64, int)) int {
+	if current < 0 {
+		return candidate
+	}
+	currentLine, currentPrice := score(books[current])
+	candidateLine, candidatePrice := score(books[candidate])
+	if currentPrice == 0 {
+		currentPrice = math.MinInt
+	}
+	if candidatePrice == 0 {
+		candidatePrice = math.MinInt
+	}
+	if candidateLine > currentLine || (candidateLine == currentLine && candidatePrice > currentPrice) {
+		return candidate
+	}
+	return current
+}
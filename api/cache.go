@@ -0,0 +1,95 @@
+package api
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// How long responses are served from the cache before asking ESPN again.
+// Expired entries are still revalidated with If-None-Match/If-Modified-Since
+// so an unchanged response costs a 304 instead of a full body.
+const (
+	scoreboardTTL       = 15 * time.Second
+	futureScoreboardTTL = 10 * time.Minute
+	liveOddsTTL         = 15 * time.Second
+	preGameOddsTTL      = 5 * time.Minute
+	finishedOddsTTL     = 6 * time.Hour
+)
+
+// Entries this far past their TTL are dropped instead of revalidated
+const cacheRetention = time.Hour
+
+type cacheEntry struct {
+	body         []byte
+	etag         string
+	lastModified string
+	expires      time.Time
+}
+
+// Response cache keyed by URL. One cache can be shared by several providers
+// so every part of the dashboard reuses the same responses.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	now     func() time.Time
+}
+
+func NewCache() *Cache {
+	return &Cache{entries: make(map[string]*cacheEntry), now: time.Now}
+}
+
+// Returns the cached entry for url, if any, and whether it is still fresh
+func (c *Cache) lookup(url string) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[url]
+	if !ok {
+		return nil, false
+	}
+	return entry, c.now().Before(entry.expires)
+}
+
+func (c *Cache) store(url string, body []byte, header http.Header, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for key, entry := range c.entries {
+		if now.Sub(entry.expires) > cacheRetention {
+			delete(c.entries, key)
+		}
+	}
+
+	c.entries[url] = &cacheEntry{
+		body:         body,
+		etag:         header.Get("ETag"),
+		lastModified: header.Get("Last-Modified"),
+		expires:      now.Add(ttl),
+	}
+}
+
+// Marks a revalidated entry fresh again
+func (c *Cache) touch(url string, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[url]; ok {
+		entry.expires = c.now().Add(ttl)
+	}
+}
+
+func (e *cacheEntry) conditional(req *http.Request) {
+	if e.etag != "" {
+		req.Header.Set("If-None-Match", e.etag)
+	}
+	if e.lastModified != "" {
+		req.Header.Set("If-Modified-Since", e.lastModified)
+	}
+}
+
+func endOfToday() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 23, 59, 59, 0, now.Location())
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	DefaultSiteURL        = "https://site.api.espn.com"
+	DefaultCoreURL        = "https://sports.core.api.espn.com"
+	DefaultRequestTimeout = 10 * time.Second
+)
+
+// Where and how the ESPN provider talks to the API. Empty fields fall back
+// to the public ESPN hosts, the default client and DefaultRequestTimeout.
+type ESPNConfig struct {
+	SiteURL string
+	CoreURL string
+	Client  *http.Client
+
+	// Shared response cache, a private one following Clock is created when
+	// nil so replays expire entries on the recorded timeline
+	Cache *Cache
+
+	// Upper bound for a single request, on top of any deadline on the
+	// caller's context
+	RequestTimeout time.Duration
+
+	// Retry and circuit breaker behaviour, zero fields are taken from
+	// DefaultRetryPolicy
+	Retry RetryPolicy
+
+	// Odds provider IDs in order of preference, DefaultOddsProviders when empty
+	OddsProviders []int
+
+	// Source of the current time for line history and the private cache,
+	// time.Now when nil
+	Clock func() time.Time
+}
+
+func (c ESPNConfig) withDefaults() ESPNConfig {
+	if c.SiteURL == "" {
+		c.SiteURL = DefaultSiteURL
+	}
+	if c.CoreURL == "" {
+		c.CoreURL = DefaultCoreURL
+	}
+	if c.Client == nil {
+		c.Client = &http.Client{}
+	}
+	if c.RequestTimeout <= 0 {
+		c.RequestTimeout = DefaultRequestTimeout
+	}
+	c.Retry = c.Retry.withDefaults()
+	if len(c.OddsProviders) == 0 {
+		c.OddsProviders = DefaultOddsProviders
+	}
+	if c.Clock == nil {
+		c.Clock = time.Now
+	}
+	if c.Cache == nil {
+		c.Cache = NewCache()
+		c.Cache.now = c.Clock
+	}
+	return c
+}
+
+// Performs a GET and returns the body of a 200 response, serving it from
+// the cache while it is younger than ttl. Transient failures are retried
+// with backoff and repeated failures trip the endpoint's circuit breaker.
+// Attempts and backoff are fitted into the time left on ctx, so a retry is
+// skipped rather than cut off by the caller's deadline.
+func (p *ESPNProvider) get(ctx context.Context, endpoint, url string, ttl time.Duration) ([]byte, error) {
+	cached, fresh := p.cache.lookup(url)
+	if fresh {
+		return cached.body, nil
+	}
+
+	breaker := p.breakers.get(endpoint)
+	if !breaker.allow() {
+		return nil, fmt.Errorf("%s: %w", endpoint, ErrCircuitOpen)
+	}
+
+	var err error
+	for attempt := 0; attempt < p.retry.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			delay := p.retry.backoff(attempt)
+			if left, ok := timeLeft(ctx); ok && delay >= left {
+				break
+			}
+			if err := sleepContext(ctx, delay); err != nil {
+				breaker.abandon()
+				return nil, err
+			}
+		}
+
+		var body []byte
+		body, err = p.fetch(ctx, url, cached, ttl, p.attemptTimeout(ctx, p.retry.MaxAttempts-attempt))
+		if err == nil {
+			breaker.success()
+			return body, nil
+		}
+		if ctx.Err() != nil {
+			breaker.abandon()
+			return nil, ctx.Err()
+		}
+		if !retryable(err) {
+			// The endpoint answered, it just didn't like the request
+			breaker.success()
+			return nil, err
+		}
+	}
+
+	breaker.failure()
+	return nil, err
+}
+
+// Request timeout for one attempt, shortened so the attempts still left
+// share whatever time remains before the caller's deadline
+func (p *ESPNProvider) attemptTimeout(ctx context.Context, attemptsLeft int) time.Duration {
+	left, ok := timeLeft(ctx)
+	if !ok || attemptsLeft <= 1 {
+		return p.requestTimeout
+	}
+	return min(p.requestTimeout, left/time.Duration(attemptsLeft))
+}
+
+// Single attempt at a GET, revalidating the cached entry if there is one
+func (p *ESPNProvider) fetch(ctx context.Context, url string, cached *cacheEntry, ttl, timeout time.Duration) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	if cached != nil {
+		cached.conditional(req)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch data: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		p.cache.touch(url, ttl)
+		return cached.body, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &StatusError{Code: resp.StatusCode}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	p.cache.store(url, body, resp.Header, ttl)
+	return body, nil
+}
@@ -1,11 +1,12 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
 	"fmt"
-	"io"
 	"net/http"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -15,13 +16,55 @@ const (
 	OddsProviderCaesar     = 38
 	OddsProviderBet365     = 2000
 	OddsProviderDraftKings = 41
+	OddsProviderESPNBet    = 58
 )
 
-var sportMap = map[string]string{
-	"nfl": "football",
-	"nba": "basketball",
-	"nhl": "hockey",
-	"mlb": "baseball",
+// Books tried in order when no preference is configured
+var DefaultOddsProviders = []int{OddsProviderDraftKings, OddsProviderCaesar, OddsProviderBet365, OddsProviderESPNBet}
+
+var oddsProviderNames = map[string]int{
+	"draftkings": OddsProviderDraftKings,
+	"caesars":    OddsProviderCaesar,
+	"bet365":     OddsProviderBet365,
+	"espnbet":    OddsProviderESPNBet,
+}
+
+// Parses a comma separated list of book names or numeric ESPN provider IDs,
+// e.g. "caesars,draftkings,2000"
+func ParseOddsProviders(list string) ([]int, error) {
+	var ids []int
+	for _, name := range strings.Split(list, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if id, ok := oddsProviderNames[name]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		id, err := strconv.Atoi(name)
+		if err != nil {
+			return nil, fmt.Errorf("unknown odds provider: %s", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Formats provider IDs the way ParseOddsProviders reads them, using book
+// names where there is one
+func FormatOddsProviders(ids []int) string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		name := strconv.Itoa(id)
+		for known, knownID := range oddsProviderNames {
+			if knownID == id {
+				name = known
+			}
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ",")
 }
 
 type Game struct {
@@ -29,20 +72,25 @@ type Game struct {
 	CompetitionID string    `json:"competition_id"`
 	HomeTeam      string    `json:"home_team"`
 	AwayTeam      string    `json:"away_team"`
+	HomeTeamID    string    `json:"home_team_id"`
+	AwayTeamID    string    `json:"away_team_id"`
 	StartTime     time.Time `json:"start_time"`
 	League        string    `json:"league"`
-	Status        string    `json:"status"`
+	State         GameState `json:"state"`
+	StatusDetail  string    `json:"status_detail"`
 	HomeScore     int       `json:"home_score"`
 	AwayScore     int       `json:"away_score"`
 	HomeRecord    string    `json:"home_record"`
 	AwayRecord    string    `json:"away_record"`
 	Clock         string    `json:"clock"`
 	Period        string    `json:"period"`
-	HomeOdds      string    `json:"home_odds"`
-	AwayOdds      string    `json:"away_odds"`
-	AwaySpread    string    `json:"away_spread"`
-	HomeSpread    string    `json:"home_spread"`
-	OverUnder     string    `json:"over_under"`
+	HomeShootout  int       `json:"home_shootout"`
+	AwayShootout  int       `json:"away_shootout"`
+	Odds          Odds      `json:"odds"`
+	Books         []Odds    `json:"books"`
+	LineHistory   LineHistory `json:"line_history"`
+	HomeInjuries  []Injury  `json:"home_injuries"`
+	AwayInjuries  []Injury  `json:"away_injuries"`
 }
 
 type ESPNResponse struct {
@@ -53,7 +101,11 @@ type ESPNResponse struct {
 		Date      string `json:"date"`
 		Status    struct {
 			Type struct {
+				Name        string `json:"name"`
+				State       string `json:"state"`
+				Completed   bool   `json:"completed"`
 				Description string `json:"description"`
+				Detail      string `json:"detail"`
 			} `json:"type"`
 			DisplayClock string `json:"displayClock"`
 			Period       int    `json:"period"`
@@ -91,8 +143,9 @@ type ESPNResponse struct {
 					Abbreviation string `json:"abbreviation"`
 					ID           string `json:"id"`
 				} `json:"team"`
-				HomeAway string `json:"homeAway"`
-				Score    string `json:"score"`
+				HomeAway      string `json:"homeAway"`
+				Score         string `json:"score"`
+				ShootoutScore int    `json:"shootoutScore"`
 				Records  []struct {
 					Name    string `json:"name"`
 					Summary string `json:"summary"`
@@ -109,61 +162,162 @@ type TeamRecord struct {
 	Record   string
 }
 
-// Fetches games for the specified league and date
-func GetGames(league string, date time.Time) ([]Game, error) {
-	var games []Game
-	leagues := []string{"nfl", "nba", "nhl", "mlb"}
+// ESPN backed implementation of ScoreProvider
+type ESPNProvider struct {
+	client         *http.Client
+	siteURL        string
+	coreURL        string
+	requestTimeout time.Duration
+	cache          *Cache
+	retry          RetryPolicy
+	breakers       *breakers
+	oddsProviders  []int
+	lines          *lineTracker
+	now            func() time.Time
+
+	// One slot per odds request in flight
+	oddsSlots chan struct{}
+}
 
-	if league != "all" {
-		leagues = []string{strings.ToLower(league)}
+func NewESPNProvider(cfg ESPNConfig) *ESPNProvider {
+	cfg = cfg.withDefaults()
+	return &ESPNProvider{
+		client:  cfg.Client,
+		siteURL: strings.TrimRight(cfg.SiteURL, "/"),
+		coreURL: strings.TrimRight(cfg.CoreURL, "/"),
+		requestTimeout: cfg.RequestTimeout,
+		cache:   cfg.Cache,
+		retry:   cfg.Retry,
+		breakers: newBreakers(cfg.Retry),
+		oddsProviders: cfg.OddsProviders,
+		lines:   newLineTracker(),
+		now:     cfg.Clock,
+		oddsSlots: make(chan struct{}, maxOddsRequests),
 	}
+}
 
-	for _, l := range leagues {
-		leagueGames, err := fetchGamesForLeague(l, date)
-		if err != nil {
-			fmt.Printf("Warning: Could not fetch games for %s: %v\n", l, err)
-			continue
+// Longest GetGames waits on odds once the scoreboards are in, and how many
+// odds requests may be in flight at once across all leagues
+const (
+	oddsBudget      = 8 * time.Second
+	maxOddsRequests = 8
+)
+
+// Fetches games for the specified league and date. Leagues are fetched
+// concurrently and a failing league doesn't fail the whole call; check
+// the per-league results for errors. Leagues still loading when ctx runs
+// out are reported as failed with the context's error.
+func (p *ESPNProvider) GetGames(ctx context.Context, league string, date time.Time) (GamesResult, error) {
+	leagues := Leagues()
+
+	if league != "all" {
+		l, ok := LookupLeague(league)
+		if !ok {
+			return GamesResult{}, fmt.Errorf("unsupported league: %s", league)
 		}
-		games = append(games, leagueGames...)
+		leagues = []League{l}
 	}
 
-	fetchAllOdds(games)
+	results := make([]LeagueResult, len(leagues))
+	var wg sync.WaitGroup
+	for i, l := range leagues {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			games, err := p.fetchGamesForLeague(ctx, l, date)
+			if err == nil {
+				p.attachInjuries(ctx, l, games)
+			}
+			results[i] = LeagueResult{
+				League:  l.Name,
+				Games:   games,
+				Err:     err,
+				Latency: time.Since(start),
+			}
+		}()
+	}
+	wg.Wait()
 
-	return games, nil
+	// Odds for every league at once, on their own budget so a slow odds
+	// endpoint leaves games without lines instead of eating into the
+	// caller's deadline
+	oddsCtx, cancel := context.WithTimeout(ctx, oddsBudget)
+	defer cancel()
+	for _, result := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.fetchAllOdds(oddsCtx, result.Games)
+		}()
+	}
+	wg.Wait()
+
+	var games []Game
+	for _, result := range results {
+		games = append(games, result.Games...)
+	}
+
+	return GamesResult{Games: games, Leagues: results}, nil
 }
 
-// Fetches games for a specific league
-func fetchGamesForLeague(league string, date time.Time) ([]Game, error) {
-	dateStr := date.Format("20060102")
-
-	// ESPN API endpoint for different leagues
-	var url string
-	switch league {
-	case "nfl":
-		url = fmt.Sprintf("https://site.api.espn.com/apis/site/v2/sports/football/nfl/scoreboard?dates=%s", dateStr)
-	case "nba":
-		url = fmt.Sprintf("https://site.api.espn.com/apis/site/v2/sports/basketball/nba/scoreboard?dates=%s", dateStr)
-	case "nhl":
-		url = fmt.Sprintf("https://site.api.espn.com/apis/site/v2/sports/hockey/nhl/scoreboard?dates=%s", dateStr)
-	case "mlb":
-		url = fmt.Sprintf("https://site.api.espn.com/apis/site/v2/sports/baseball/mlb/scoreboard?dates=%s", dateStr)
-	default:
+// Fills in the odds for a single game
+func (p *ESPNProvider) GetOdds(ctx context.Context, game *Game) error {
+	return p.fetchOddsForGame(ctx, game, p.oddsProviders)
+}
+
+// How far ahead NextGame looks, in days
+const nextGameWindow = 30
+
+// Finds the first game in the league starting after the given time. The
+// whole window is a single scoreboard request over a date range, and only
+// the game found gets its odds and injuries looked up.
+func (p *ESPNProvider) NextGame(ctx context.Context, league string, after time.Time) (*Game, error) {
+	l, ok := LookupLeague(league)
+	if !ok {
 		return nil, fmt.Errorf("unsupported league: %s", league)
 	}
 
-	resp, err := http.Get(url)
+	// Only start times matter here and those rarely move, so the range is
+	// cached like a future slate even though it starts today
+	dates := after.Format("20060102") + "-" + after.AddDate(0, 0, nextGameWindow).Format("20060102")
+	games, err := p.fetchScoreboard(ctx, l, dates, futureScoreboardTTL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch data: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+	sort.Slice(games, func(i, j int) bool {
+		return games[i].StartTime.Before(games[j].StartTime)
+	})
+	for i := range games {
+		if games[i].StartTime.After(after) {
+			next := games[i : i+1]
+			p.attachInjuries(ctx, l, next)
+			p.GetOdds(ctx, &next[0])
+			return &next[0], nil
+		}
 	}
+	return nil, nil
+}
+
+// Fetches games for a specific league
+func (p *ESPNProvider) fetchGamesForLeague(ctx context.Context, league League, date time.Time) ([]Game, error) {
+	// Future slates only change when lines or start times move
+	ttl := scoreboardTTL
+	if date.After(endOfToday()) {
+		ttl = futureScoreboardTTL
+	}
+	return p.fetchScoreboard(ctx, league, date.Format("20060102"), ttl)
+}
+
+// Fetches a league's scoreboard for a day ("20060102") or a range of days
+// ("20060102-20060109")
+func (p *ESPNProvider) fetchScoreboard(ctx context.Context, league League, dates string, ttl time.Duration) ([]Game, error) {
+	url := fmt.Sprintf("%s/apis/site/v2/sports/%s/%s/scoreboard?dates=%s", p.siteURL, league.Sport, league.Path, dates)
 
-	body, err := io.ReadAll(resp.Body)
+	body, err := p.get(ctx, "scoreboard/"+league.ID, url, ttl)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, err
 	}
 
 	var espnResp ESPNResponse
@@ -173,17 +327,9 @@ func fetchGamesForLeague(league string, date time.Time) ([]Game, error) {
 
 	var games []Game
 	for _, event := range espnResp.Events {
-		// Fixing the game start time
-		startTime, err := time.Parse(time.RFC3339, event.Date)
-		clock := event.Status.DisplayClock
-		period := formatPeriod(event.Status.Period, league)
-		if err != nil {
-			startTime, err = time.Parse("2006-01-02T15:04Z", event.Date)
-			if err != nil {
-				fmt.Printf("Warning: Could not parse date '%s' for game %s: %v\n", event.Date, event.Name, err)
-				startTime = time.Now()
-			}
-		}
+		startTime := parseEventTime(event.Date)
+		clock := league.FormatClock(event.Status.DisplayClock)
+		period := league.PeriodLabel(event.Status.Period)
 
 		// Process team sports
 		if len(event.Competitions) == 0 || len(event.Competitions[0].Competitors) < 2 {
@@ -193,22 +339,28 @@ func fetchGamesForLeague(league string, date time.Time) ([]Game, error) {
 		comp := event.Competitions[0]
 
 		var homeTeam, awayTeam string
+		var homeTeamID, awayTeamID string
 		var homeScore, awayScore int
+		var homeShootout, awayShootout int
 		var homeRecord, awayRecord string
 
 		for _, competitor := range comp.Competitors {
 			// Extract record from the competitor data
-			record := extractRecord(competitor.Records, league)
+			record := extractRecord(competitor.Records)
 
 			if competitor.HomeAway == "home" {
 				homeTeam = competitor.Team.DisplayName
+				homeTeamID = competitor.Team.ID
 				homeRecord = record
+				homeShootout = competitor.ShootoutScore
 				if competitor.Score != "" {
 					fmt.Sscanf(competitor.Score, "%d", &homeScore)
 				}
 			} else {
 				awayTeam = competitor.Team.DisplayName
+				awayTeamID = competitor.Team.ID
 				awayRecord = record
+				awayShootout = competitor.ShootoutScore
 				if competitor.Score != "" {
 					fmt.Sscanf(competitor.Score, "%d", &awayScore)
 				}
@@ -226,11 +378,16 @@ func fetchGamesForLeague(league string, date time.Time) ([]Game, error) {
 			CompetitionID: compID,
 			HomeTeam:      homeTeam,
 			AwayTeam:      awayTeam,
+			HomeTeamID:    homeTeamID,
+			AwayTeamID:    awayTeamID,
 			StartTime:     startTime,
-			League:        strings.ToUpper(league),
-			Status:        event.Status.Type.Description,
+			League:        league.Name,
+			State:         parseGameState(event.Status.Type.Name, event.Status.Type.State, event.Status.Type.Completed),
+			StatusDetail:  statusDetail(event.Status.Type.Detail, event.Status.Type.Description),
 			HomeScore:     homeScore,
 			AwayScore:     awayScore,
+			HomeShootout:  homeShootout,
+			AwayShootout:  awayShootout,
 			HomeRecord:    homeRecord,
 			AwayRecord:    awayRecord,
 			Clock:         clock,
@@ -243,135 +400,57 @@ func fetchGamesForLeague(league string, date time.Time) ([]Game, error) {
 	return games, nil
 }
 
-func formatPeriod(period int, league string) string {
-	switch league {
-	case "nfl":
-		switch period {
-		case 1:
-			return "1st Qtr"
-		case 2:
-			return "2nd Qtr"
-		case 3:
-			return "3rd Qtr"
-		case 4:
-			return "4th Qtr"
-		case 5:
-			return "OT"
-		default:
-			return ""
-		}
-
-	case "nba":
-		switch period {
-		case 1:
-			return "1st Qtr"
-		case 2:
-			return "2nd Qtr"
-		case 3:
-			return "3rd Qtr"
-		case 4:
-			return "4th Qtr"
-		case 5:
-			return "OT"
-		default:
-			return ""
-		}
-
-	case "nhl":
-		switch period {
-		case 1:
-			return "1st Per"
-		case 2:
-			return "2nd Per"
-		case 3:
-			return "3rd Per"
-		case 4:
-			return "OT"
-		default:
-			return ""
+// ESPN dates come with or without seconds
+func parseEventTime(date string) time.Time {
+	startTime, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		startTime, err = time.Parse("2006-01-02T15:04Z", date)
+		if err != nil {
+			startTime = time.Now()
 		}
+	}
+	return startTime
+}
 
-	case "mlb":
-		switch period {
-		case 1:
-			return "1st Inn"
-		case 2:
-			return "2nd Inn"
-		case 3:
-			return "3rd Inn"
-		case 4:
-			return "4th Inn"
-		case 5:
-			return "5th Inn"
-		case 6:
-			return "6th Inn"
-		case 7:
-			return "7th Inn"
-		case 8:
-			return "8th Inn"
-		case 9:
-			return "9th Inn"
-		case 10:
-			return "Extra Inn"
-		default:
-			return fmt.Sprintf("%dth", period)
-		}
-	default:
-		return ""
+// ESPN's detail text ("Final/3OT", "Rain Delay"), or the description when missing
+func statusDetail(detail, description string) string {
+	if detail != "" {
+		return detail
 	}
+	return description
 }
 
-// Extracts the appropriate record from the records array
+// Extracts the overall record from the records array
 func extractRecord(records []struct {
 	Name    string `json:"name"`
 	Summary string `json:"summary"`
 	Type    string `json:"type"`
-}, league string) string {
+}) string {
 
 	if len(records) == 0 {
 		return ""
 	}
 
-	// For different leagues, look for different record types
-	switch league {
-	case "nfl":
-		for _, record := range records {
-			if record.Name == "overall" || record.Type == "total" {
-				return record.Summary
-			}
-		}
-	case "nba", "nhl":
-		for _, record := range records {
-			if record.Name == "overall" || record.Type == "total" {
-				return record.Summary
-			}
+	for _, record := range records {
+		if record.Name == "overall" || record.Type == "total" {
+			return record.Summary
 		}
-	case "mlb":
-		for _, record := range records {
-			if record.Name == "overall" || record.Type == "total" {
-				return record.Summary
-			}
-		}
-	}
-
-	// If no specific record found, return the first one
-	if len(records) > 0 {
-		return records[0].Summary
 	}
 
-	return ""
+	// If no overall record found, return the first one
+	return records[0].Summary
 }
 
-func fetchAllOdds(games []Game) {
+func (p *ESPNProvider) fetchAllOdds(ctx context.Context, games []Game) {
 	var wg sync.WaitGroup
+	// The cache decides what is asked for again: live lines often, finished
+	// games' closing lines hardly ever
 	for i := range games {
-		if games[i].OverUnder == "" && games[i].HomeSpread == ""{
-			wg.Add(1)
-			go func(game *Game) {
-				defer wg.Done()
-				fetchOddsForGame(game, OddsProviderDraftKings)
-			}(&games[i])
-		}
+		wg.Add(1)
+		go func(game *Game) {
+			defer wg.Done()
+			p.GetOdds(ctx, game)
+		}(&games[i])
 	}
 	wg.Wait()
 }
@@ -384,8 +463,11 @@ type OddsItem struct {
 	Provider Provider `json:"provider"`
 	Spread   float64  `json:"spread"`
 	OverUnder float64 `json:"overUnder"`
+	OverOdds  int     `json:"overOdds"`
+	UnderOdds int     `json:"underOdds"`
 	HomeTeamOdds TeamOdds `json:"homeTeamOdds"`
 	AwayTeamOdds TeamOdds `json:"awayTeamOdds"`
+	DrawOdds     TeamOdds `json:"drawOdds"`
 }
 
 type Provider struct {
@@ -396,85 +478,68 @@ type Provider struct {
 type TeamOdds struct {
 	Favorite   bool `json:"favorite"`
 	MoneyLine  int `json:"moneyLine"`
+	SpreadOdds int `json:"spreadOdds"`
 }
 
 
-func fetchOddsForGame(game *Game, providerID int) {
-	sport, ok := sportMap[strings.ToLower(game.League)]
+func (p *ESPNProvider) fetchOddsForGame(ctx context.Context, game *Game, preference []int) error {
+	league, ok := LookupLeague(game.League)
 	if !ok {
-		return
+		return fmt.Errorf("unsupported league: %s", game.League)
 	}
-	league := strings.ToLower(game.League)
-	url := fmt.Sprintf("https://sports.core.api.espn.com/v2/sports/%s/leagues/%s/events/%s/competitions/%s/odds?lang=en&region=us", sport, league, game.EventID, game.CompetitionID)
-
-	resp, err := http.Get(url)
-	if err != nil {
-		return
+	url := fmt.Sprintf("%s/v2/sports/%s/leagues/%s/events/%s/competitions/%s/odds?lang=en&region=us", p.coreURL, league.Sport, league.Path, game.EventID, game.CompetitionID)
+
+	ttl := liveOddsTTL
+	switch {
+	case game.State.IsFinished():
+		ttl = finishedOddsTTL
+	case game.StartTime.After(p.now()):
+		ttl = preGameOddsTTL
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return
+	select {
+	case p.oddsSlots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
 	}
-
-	body, err := io.ReadAll(resp.Body)
+	body, err := p.get(ctx, "odds/"+league.ID, url, ttl)
+	<-p.oddsSlots
 	if err != nil {
-		return
+		return err
 	}
 
 	var odds OddsResponse
 	if err := json.Unmarshal(body, &odds); err != nil {
-		return
+		return fmt.Errorf("failed to parse odds JSON: %w", err)
 	}
 
-	// Multiple odds providers
+	game.Books = make([]Odds, 0, len(odds.Items))
 	for _, item := range odds.Items {
-		if item.Provider.ID == strconv.Itoa(providerID) {
-			continue
-		}
-
-		applyOddsToGame(game, item)
-		return
+		game.Books = append(game.Books, item.odds())
 	}
-}
-
-func applyOddsToGame(game *Game, odds OddsItem) {
-	if odds.Spread != 0 {
-		spread := odds.Spread
-		if spread < 0 {
-			spread = -spread
-		}
-
-	if odds.HomeTeamOdds.Favorite {
-			game.HomeSpread = fmt.Sprintf("-%.1f", spread)
-			game.AwaySpread = fmt.Sprintf("+%.1f", spread)
-		} else if odds.AwayTeamOdds.Favorite {
-			game.AwaySpread = fmt.Sprintf("-%.1f", spread)
-			game.HomeSpread = fmt.Sprintf("+%.1f", spread)
+	if item, ok := preferredOdds(odds.Items, preference); ok {
+		game.Odds = item.odds()
+		if game.State.IsFinished() {
+			p.lines.forget(game.EventID)
 		} else {
-			// No favorite marked, default home as favorite
-			game.HomeSpread = fmt.Sprintf("-%.1f", spread)
-			game.AwaySpread = fmt.Sprintf("+%.1f", spread)
+			game.LineHistory = p.lines.record(game.EventID, LineSnapshot{Time: p.now(), Odds: game.Odds})
 		}
 	}
+	return nil
+}
 
-	if odds.OverUnder != 0 {
-		game.OverUnder = fmt.Sprintf("O/U %.1f", odds.OverUnder)
-	}
-
-	// Moneyline odds
-	if odds.HomeTeamOdds.MoneyLine != 0 {
-		if odds.HomeTeamOdds.MoneyLine > 0 {
-			game.HomeOdds = fmt.Sprintf("+%d", odds.HomeTeamOdds.MoneyLine)
-		} else {
-			game.HomeOdds = fmt.Sprintf("%d", odds.HomeTeamOdds.MoneyLine)
+// Picks the first book in preference order that has a line, falling back
+// to whichever book ESPN lists first
+func preferredOdds(items []OddsItem, preference []int) (OddsItem, bool) {
+	for _, id := range preference {
+		for _, item := range items {
+			if item.Provider.ID == strconv.Itoa(id) {
+				return item, true
+			}
 		}
 	}
-	if odds.AwayTeamOdds.MoneyLine != 0 {
-		if odds.AwayTeamOdds.MoneyLine > 0 {
-			game.AwayOdds = fmt.Sprintf("+%d", odds.AwayTeamOdds.MoneyLine)
-		} else {
-			game.AwayOdds = fmt.Sprintf("%d", odds.AwayTeamOdds.MoneyLine)
-		}
+	if len(items) > 0 {
+		return items[0], true
 	}
+	return OddsItem{}, false
 }
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const injuriesTTL = 15 * time.Minute
+
+// A player on a team's injury report
+type Injury struct {
+	Player   string
+	Position string
+	Status   string
+	Detail   string
+}
+
+// Statuses that put a player's availability for the next game in doubt.
+// Long-term lists like injured reserve are left out.
+var keyInjuryStatuses = map[string]bool{
+	"Out":          true,
+	"Doubtful":     true,
+	"Questionable": true,
+	"Day-To-Day":   true,
+}
+
+// Optional provider capability for injury reports
+type InjuryProvider interface {
+	// Key injuries for every team in the league, keyed by team ID
+	GetInjuries(ctx context.Context, league string) (map[string][]Injury, error)
+}
+
+var _ InjuryProvider = (*ESPNProvider)(nil)
+
+type espnInjuries struct {
+	Injuries []struct {
+		ID       string `json:"id"`
+		Injuries []struct {
+			Status       string `json:"status"`
+			ShortComment string `json:"shortComment"`
+			Athlete      struct {
+				DisplayName string `json:"displayName"`
+				Position    struct {
+					Abbreviation string `json:"abbreviation"`
+				} `json:"position"`
+			} `json:"athlete"`
+		} `json:"injuries"`
+	} `json:"injuries"`
+}
+
+// Fetches the league's injury report, keeping key injuries only
+func (p *ESPNProvider) GetInjuries(ctx context.Context, name string) (map[string][]Injury, error) {
+	league, ok := LookupLeague(name)
+	if !ok {
+		return nil, fmt.Errorf("unsupported league: %s", name)
+	}
+	url := fmt.Sprintf("%s/apis/site/v2/sports/%s/%s/injuries", p.siteURL, league.Sport, league.Path)
+
+	body, err := p.get(ctx, "injuries/"+league.ID, url, injuriesTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw espnInjuries
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse injuries JSON: %w", err)
+	}
+
+	byTeam := make(map[string][]Injury)
+	for _, team := range raw.Injuries {
+		for _, injury := range team.Injuries {
+			if !keyInjuryStatuses[injury.Status] {
+				continue
+			}
+			byTeam[team.ID] = append(byTeam[team.ID], Injury{
+				Player:   injury.Athlete.DisplayName,
+				Position: injury.Athlete.Position.Abbreviation,
+				Status:   injury.Status,
+				Detail:   injury.ShortComment,
+			})
+		}
+	}
+	return byTeam, nil
+}
+
+// Attaches injury reports to games that haven't started. Injuries are extra
+// information, so a failed lookup leaves the games untouched.
+func (p *ESPNProvider) attachInjuries(ctx context.Context, league League, games []Game) {
+	upcoming := false
+	for _, game := range games {
+		if game.State == Scheduled {
+			upcoming = true
+			break
+		}
+	}
+	if !upcoming {
+		return
+	}
+
+	byTeam, err := p.GetInjuries(ctx, league.ID)
+	if err != nil {
+		return
+	}
+	for i := range games {
+		if games[i].State != Scheduled {
+			continue
+		}
+		games[i].HomeInjuries = byTeam[games[i].HomeTeamID]
+		games[i].AwayInjuries = byTeam[games[i].AwayTeamID]
+	}
+}
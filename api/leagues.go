@@ -0,0 +1,115 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Everything the dashboard needs to know about a league
+type League struct {
+	// Short identifier used on the command line and in cache keys
+	ID string
+	// Display name, also stored on Game.League
+	Name string
+	// ESPN URL segments, e.g. football/college-football
+	Sport string
+	Path  string
+	// tview color tag for the league's section
+	Color string
+	// Regulation periods and what one is called ("Qtr", "Half", ...)
+	Periods    int
+	PeriodUnit string
+	// Whether periods past regulation are overtime rather than more of the
+	// same unit, like extra innings
+	Overtime bool
+	// Names for the periods after regulation when they aren't plain
+	// overtimes, e.g. soccer's extra time halves and shootout
+	ExtraPeriods []string
+	// Games can end level, settled with a three-way moneyline
+	Draws bool
+	// Match clock counts up in minutes ("67'", "90'+3'")
+	MinuteClock bool
+}
+
+var soccerExtraPeriods = []string{"ET 1st Half", "ET 2nd Half", "Penalties"}
+
+// Known leagues in their default display order
+var leagues = []League{
+	{ID: "nfl", Name: "NFL", Sport: "football", Path: "nfl", Color: "red", Periods: 4, PeriodUnit: "Qtr", Overtime: true},
+	{ID: "nba", Name: "NBA", Sport: "basketball", Path: "nba", Color: "blue", Periods: 4, PeriodUnit: "Qtr", Overtime: true},
+	{ID: "nhl", Name: "NHL", Sport: "hockey", Path: "nhl", Color: "orange", Periods: 3, PeriodUnit: "Per", Overtime: true},
+	{ID: "mlb", Name: "MLB", Sport: "baseball", Path: "mlb", Color: "green", Periods: 9, PeriodUnit: "Inn"},
+	{ID: "ncaaf", Name: "NCAAF", Sport: "football", Path: "college-football", Color: "gold", Periods: 4, PeriodUnit: "Qtr", Overtime: true},
+	{ID: "ncaab", Name: "NCAAB", Sport: "basketball", Path: "mens-college-basketball", Color: "teal", Periods: 2, PeriodUnit: "Half", Overtime: true},
+	{ID: "ncaaw", Name: "NCAAW", Sport: "basketball", Path: "womens-college-basketball", Color: "fuchsia", Periods: 4, PeriodUnit: "Qtr", Overtime: true},
+	{ID: "wnba", Name: "WNBA", Sport: "basketball", Path: "wnba", Color: "coral", Periods: 4, PeriodUnit: "Qtr", Overtime: true},
+	{ID: "mls", Name: "MLS", Sport: "soccer", Path: "usa.1", Color: "lime", Periods: 2, PeriodUnit: "Half", ExtraPeriods: soccerExtraPeriods, Draws: true, MinuteClock: true},
+	{ID: "epl", Name: "EPL", Sport: "soccer", Path: "eng.1", Color: "mediumpurple", Periods: 2, PeriodUnit: "Half", ExtraPeriods: soccerExtraPeriods, Draws: true, MinuteClock: true},
+	{ID: "ucl", Name: "UCL", Sport: "soccer", Path: "uefa.champions", Color: "skyblue", Periods: 2, PeriodUnit: "Half", ExtraPeriods: soccerExtraPeriods, Draws: true, MinuteClock: true},
+}
+
+// All known leagues in default display order
+func Leagues() []League {
+	return append([]League(nil), leagues...)
+}
+
+// Finds a league by ID, display name or ESPN path, ignoring case
+func LookupLeague(name string) (League, bool) {
+	for _, league := range leagues {
+		if strings.EqualFold(name, league.ID) || strings.EqualFold(name, league.Name) || strings.EqualFold(name, league.Path) {
+			return league, true
+		}
+	}
+	return League{}, false
+}
+
+// Label for a period number, e.g. "3rd Qtr", "2nd Half", "OT", "2OT", "11th Inn"
+func (l League) PeriodLabel(period int) string {
+	if period <= 0 {
+		return ""
+	}
+	if period > l.Periods && period-l.Periods <= len(l.ExtraPeriods) {
+		return l.ExtraPeriods[period-l.Periods-1]
+	}
+	if period <= l.Periods || !l.Overtime {
+		return fmt.Sprintf("%s %s", ordinal(period), l.PeriodUnit)
+	}
+
+	overtime := period - l.Periods
+	if overtime == 1 {
+		return "OT"
+	}
+	return fmt.Sprintf("%dOT", overtime)
+}
+
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 10 {
+	case 1:
+		suffix = "st"
+	case 2:
+		suffix = "nd"
+	case 3:
+		suffix = "rd"
+	}
+	if n%100 >= 11 && n%100 <= 13 {
+		suffix = "th"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+// Normalizes ESPN's display clock. Soccer minutes come as "67'" or
+// "90'+3'" in stoppage time, which reads better as "90+3'".
+func (l League) FormatClock(clock string) string {
+	if !l.MinuteClock {
+		return clock
+	}
+	clock = strings.TrimSpace(clock)
+	if clock == "" || clock == "0'" {
+		return ""
+	}
+	if base, added, ok := strings.Cut(clock, "+"); ok {
+		return strings.TrimSuffix(base, "'") + "+" + strings.TrimSuffix(added, "'") + "'"
+	}
+	return clock
+}
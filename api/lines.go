@@ -0,0 +1,107 @@
+package api
+
+import (
+	"sync"
+	"time"
+)
+
+// Most snapshots kept per game; the opening line is always kept
+const maxLineSnapshots = 50
+
+// Games whose line hasn't been seen for this long are forgotten
+const lineHistoryMaxAge = 12 * time.Hour
+
+// The preferred book's line for a game at one point in time
+type LineSnapshot struct {
+	Time time.Time `json:"time"`
+	Odds
+}
+
+// Whether two snapshots are the same book offering the same line
+func (s LineSnapshot) sameLine(other LineSnapshot) bool {
+	return s.Odds == other.Odds
+}
+
+// Every distinct line seen for a game, oldest first
+type LineHistory []LineSnapshot
+
+// First line seen for the game, ok is false with no history
+func (h LineHistory) Opening() (LineSnapshot, bool) {
+	if len(h) == 0 {
+		return LineSnapshot{}, false
+	}
+	return h[0], true
+}
+
+// Same book's line before the latest move, ok is false until that book's
+// line has moved. Switching books isn't a move.
+func (h LineHistory) Previous() (LineSnapshot, bool) {
+	current, ok := h.Current()
+	if !ok {
+		return LineSnapshot{}, false
+	}
+	for i := len(h) - 2; i >= 0; i-- {
+		if h[i].Provider != current.Provider {
+			continue
+		}
+		if h[i].sameLine(current) {
+			return LineSnapshot{}, false
+		}
+		return h[i], true
+	}
+	return LineSnapshot{}, false
+}
+
+func (h LineHistory) Current() (LineSnapshot, bool) {
+	if len(h) == 0 {
+		return LineSnapshot{}, false
+	}
+	return h[len(h)-1], true
+}
+
+// Remembers the line history of open games across refreshes, keyed by
+// event ID. A snapshot is only added when the line moves or the book
+// changes.
+type lineTracker struct {
+	mu      sync.Mutex
+	history map[string]trackedLine
+}
+
+type trackedLine struct {
+	history LineHistory
+	seen    time.Time
+}
+
+func newLineTracker() *lineTracker {
+	return &lineTracker{history: make(map[string]trackedLine)}
+}
+
+// Adds the snapshot if it differs from the latest one and returns a copy
+// of the game's history. Games not seen for lineHistoryMaxAge are dropped.
+func (t *lineTracker) record(eventID string, snap LineSnapshot) LineHistory {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for id, tracked := range t.history {
+		if snap.Time.Sub(tracked.seen) > lineHistoryMaxAge {
+			delete(t.history, id)
+		}
+	}
+
+	history := t.history[eventID].history
+	if current, ok := history.Current(); !ok || !current.sameLine(snap) {
+		history = append(history, snap)
+		if len(history) > maxLineSnapshots {
+			history = append(history[:1], history[2:]...)
+		}
+	}
+	t.history[eventID] = trackedLine{history: history, seen: snap.Time}
+	return append(LineHistory(nil), history...)
+}
+
+// Drops a game's history, once it is over there are no more lines
+func (t *lineTracker) forget(eventID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.history, eventID)
+}
@@ -0,0 +1,53 @@
+package api
+
+import "math"
+
+// A book's line for a game. Spreads are from each side's point of view,
+// negative for the favorite; prices are American odds. Zero means the book
+// doesn't offer that market.
+type Odds struct {
+	Provider       string  `json:"provider"`
+	HomeSpread     float64 `json:"home_spread"`
+	AwaySpread     float64 `json:"away_spread"`
+	HomeSpreadOdds int     `json:"home_spread_odds"`
+	AwaySpreadOdds int     `json:"away_spread_odds"`
+	HomeMoneyLine  int     `json:"home_money_line"`
+	AwayMoneyLine  int     `json:"away_money_line"`
+	DrawMoneyLine  int     `json:"draw_money_line"`
+	Total          float64 `json:"total"`
+	OverOdds       int     `json:"over_odds"`
+	UnderOdds      int     `json:"under_odds"`
+}
+
+func (o Odds) HasSpread() bool {
+	return o.HomeSpread != 0 || o.AwaySpread != 0
+}
+
+func (o Odds) HasTotal() bool {
+	return o.Total != 0
+}
+
+// Converts ESPN's odds item. ESPN lists the spread without a reliable sign,
+// so the favorite flag decides which side gives the points, defaulting to
+// home when neither is marked.
+func (item OddsItem) odds() Odds {
+	odds := Odds{
+		Provider:       item.Provider.Name,
+		HomeSpreadOdds: item.HomeTeamOdds.SpreadOdds,
+		AwaySpreadOdds: item.AwayTeamOdds.SpreadOdds,
+		HomeMoneyLine:  item.HomeTeamOdds.MoneyLine,
+		AwayMoneyLine:  item.AwayTeamOdds.MoneyLine,
+		DrawMoneyLine:  item.DrawOdds.MoneyLine,
+		Total:          item.OverUnder,
+		OverOdds:       item.OverOdds,
+		UnderOdds:      item.UnderOdds,
+	}
+	if item.Spread != 0 {
+		spread := math.Abs(item.Spread)
+		odds.HomeSpread, odds.AwaySpread = -spread, spread
+		if item.AwayTeamOdds.Favorite && !item.HomeTeamOdds.Favorite {
+			odds.HomeSpread, odds.AwaySpread = spread, -spread
+		}
+	}
+	return odds
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	playsTTL      = 10 * time.Second
+	playsPageSize = 500
+)
+
+// A single play from the play-by-play feed
+type Play struct {
+	ID         string
+	Sequence   int
+	Type       string
+	Text       string
+	Period     int
+	Clock      string
+	TeamID     string
+	Scoring    bool
+	ScoreValue int
+	AwayScore  int
+	HomeScore  int
+}
+
+// Optional provider capability for play-by-play
+type PlaysProvider interface {
+	// Every play so far, ordered by sequence
+	GetPlays(ctx context.Context, game Game) ([]Play, error)
+}
+
+var _ PlaysProvider = (*ESPNProvider)(nil)
+
+type espnPlays struct {
+	PageIndex int `json:"pageIndex"`
+	PageCount int `json:"pageCount"`
+	Items     []struct {
+		ID             string `json:"id"`
+		SequenceNumber string `json:"sequenceNumber"`
+		Type           struct {
+			Text string `json:"text"`
+		} `json:"type"`
+		Text      string `json:"text"`
+		AwayScore int    `json:"awayScore"`
+		HomeScore int    `json:"homeScore"`
+		Period    struct {
+			Number int `json:"number"`
+		} `json:"period"`
+		Clock struct {
+			DisplayValue string `json:"displayValue"`
+		} `json:"clock"`
+		ScoringPlay bool `json:"scoringPlay"`
+		ScoreValue  int  `json:"scoreValue"`
+		Team        struct {
+			Ref string `json:"$ref"`
+		} `json:"team"`
+	} `json:"items"`
+}
+
+// Team references look like .../teams/12?lang=en
+var teamRefID = regexp.MustCompile(`/teams/(\d+)`)
+
+// Fetches the full play-by-play for a game, following pagination
+func (p *ESPNProvider) GetPlays(ctx context.Context, game Game) ([]Play, error) {
+	league, ok := LookupLeague(game.League)
+	if !ok {
+		return nil, fmt.Errorf("unsupported league: %s", game.League)
+	}
+
+	var plays []Play
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("%s/v2/sports/%s/leagues/%s/events/%s/competitions/%s/plays?limit=%d&page=%d",
+			p.coreURL, league.Sport, league.Path, game.EventID, game.CompetitionID, playsPageSize, page)
+
+		body, err := p.get(ctx, "plays/"+league.ID, url, playsTTL)
+		if err != nil {
+			return nil, err
+		}
+
+		var raw espnPlays
+		if err := json.Unmarshal(body, &raw); err != nil {
+			return nil, fmt.Errorf("failed to parse plays JSON: %w", err)
+		}
+
+		for _, item := range raw.Items {
+			seq, _ := strconv.Atoi(item.SequenceNumber)
+			play := Play{
+				ID:         item.ID,
+				Sequence:   seq,
+				Type:       item.Type.Text,
+				Text:       item.Text,
+				Period:     item.Period.Number,
+				Clock:      item.Clock.DisplayValue,
+				Scoring:    item.ScoringPlay,
+				ScoreValue: item.ScoreValue,
+				AwayScore:  item.AwayScore,
+				HomeScore:  item.HomeScore,
+			}
+			if match := teamRefID.FindStringSubmatch(item.Team.Ref); match != nil {
+				play.TeamID = match[1]
+			}
+			plays = append(plays, play)
+		}
+
+		if page >= raw.PageCount {
+			break
+		}
+	}
+
+	sort.SliceStable(plays, func(i, j int) bool {
+		return plays[i].Sequence < plays[j].Sequence
+	})
+	return plays, nil
+}
+
+// Polls a live game's play-by-play and hands back only what's new since
+// the previous poll
+type PlayWatcher struct {
+	mu       sync.Mutex
+	provider PlaysProvider
+	game     Game
+	seen     map[string]bool
+}
+
+func NewPlayWatcher(provider PlaysProvider, game Game) *PlayWatcher {
+	return &PlayWatcher{
+		provider: provider,
+		game:     game,
+		seen:     make(map[string]bool),
+	}
+}
+
+// Returns plays not returned by an earlier call, in sequence order. The
+// first call returns everything so far.
+func (w *PlayWatcher) Poll(ctx context.Context) ([]Play, error) {
+	plays, err := w.provider.GetPlays(ctx, w.game)
+	if err != nil {
+		return nil, err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var fresh []Play
+	for _, play := range plays {
+		if w.seen[play.ID] {
+			continue
+		}
+		w.seen[play.ID] = true
+		fresh = append(fresh, play)
+	}
+	return fresh, nil
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"time"
+)
+
+// Source of scores and betting lines for the dashboard. Every call gives up
+// as soon as ctx is cancelled or its deadline passes.
+type ScoreProvider interface {
+	// Games for a league ("all" for every league) on the given date
+	GetGames(ctx context.Context, league string, date time.Time) (GamesResult, error)
+
+	// Fills in the betting lines for a single game
+	GetOdds(ctx context.Context, game *Game) error
+
+	// First game in the league starting after the given time, nil if none found
+	NextGame(ctx context.Context, league string, after time.Time) (*Game, error)
+}
+
+// Outcome of fetching a single league
+type LeagueResult struct {
+	League  string
+	Games   []Game
+	Err     error
+	Latency time.Duration
+}
+
+// Games from every requested league along with how each league fared
+type GamesResult struct {
+	Games   []Game
+	Leagues []LeagueResult
+}
+
+// Leagues that could not be fetched
+func (r GamesResult) Failed() []LeagueResult {
+	var failed []LeagueResult
+	for _, league := range r.Leagues {
+		if league.Err != nil {
+			failed = append(failed, league)
+		}
+	}
+	return failed
+}
+
+var _ ScoreProvider = (*ESPNProvider)(nil)
@@ -0,0 +1,259 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const recordIndex = "index.jsonl"
+
+// One saved response, as listed in the recording index
+type recordEntry struct {
+	Time   time.Time `json:"time"`
+	URL    string    `json:"url"`
+	Status int       `json:"status"`
+	File   string    `json:"file"`
+}
+
+// Transport that saves every raw response it sees to a directory, along with
+// an index of when and where each one was fetched
+type Recorder struct {
+	mu    sync.Mutex
+	dir   string
+	next  http.RoundTripper
+	index *os.File
+	seq   int
+}
+
+func NewRecorder(dir string, next http.RoundTripper) (*Recorder, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create record dir: %w", err)
+	}
+	seq, err := lastRecorded(dir)
+	if err != nil {
+		return nil, err
+	}
+	index, err := os.OpenFile(filepath.Join(dir, recordIndex), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open record index: %w", err)
+	}
+	return &Recorder{dir: dir, next: next, index: index, seq: seq}, nil
+}
+
+// Highest file number in an existing recording, so recording into the same
+// directory again appends to it instead of overwriting earlier responses
+func lastRecorded(dir string) (int, error) {
+	f, err := os.Open(filepath.Join(dir, recordIndex))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to open record index: %w", err)
+	}
+	defer f.Close()
+
+	last := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry recordEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return 0, fmt.Errorf("failed to parse record index: %w", err)
+		}
+		var seq int
+		if _, err := fmt.Sscanf(entry.File, "%d-", &seq); err == nil {
+			last = max(last, seq)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read record index: %w", err)
+	}
+	return last, nil
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	// Recording is best effort, a full disk shouldn't take the dashboard down.
+	// 304s carry no body, replay falls back to the earlier full response.
+	if resp.StatusCode != http.StatusNotModified {
+		r.save(time.Now(), req.URL.String(), resp.StatusCode, body)
+	}
+	return resp, nil
+}
+
+func (r *Recorder) save(at time.Time, url string, status int, body []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.seq++
+	file := fmt.Sprintf("%06d-%s.json", r.seq, endpointKind(url))
+	if err := os.WriteFile(filepath.Join(r.dir, file), body, 0o644); err != nil {
+		return
+	}
+
+	line, err := json.Marshal(recordEntry{Time: at, URL: url, Status: status, File: file})
+	if err != nil {
+		return
+	}
+	r.index.Write(append(line, '\n'))
+}
+
+func (r *Recorder) Close() error {
+	return r.index.Close()
+}
+
+// Transport that serves a recording back, answering each request with the
+// latest response for that URL at the current point of the recorded timeline
+type Replayer struct {
+	dir     string
+	entries map[string][]recordEntry
+	start   time.Time
+	began   time.Time
+	speed   float64
+}
+
+func NewReplayer(dir string, speed float64) (*Replayer, error) {
+	if speed <= 0 {
+		speed = 1
+	}
+	f, err := os.Open(filepath.Join(dir, recordIndex))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording: %w", err)
+	}
+	defer f.Close()
+
+	r := &Replayer{dir: dir, entries: make(map[string][]recordEntry), speed: speed}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry recordEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("failed to parse recording index: %w", err)
+		}
+		key := replayKey(entry.URL)
+		r.entries[key] = append(r.entries[key], entry)
+		if r.start.IsZero() || entry.Time.Before(r.start) {
+			r.start = entry.Time
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read recording index: %w", err)
+	}
+	if len(r.entries) == 0 {
+		return nil, fmt.Errorf("recording in %s is empty", dir)
+	}
+
+	for _, list := range r.entries {
+		sort.Slice(list, func(i, j int) bool {
+			return list[i].Time.Before(list[j].Time)
+		})
+	}
+	r.began = time.Now()
+	return r, nil
+}
+
+// Wall clock time it takes to play back d of the recording
+func (r *Replayer) Scale(d time.Duration) time.Duration {
+	return time.Duration(float64(d) / r.speed)
+}
+
+// Current point on the recorded timeline
+func (r *Replayer) Now() time.Time {
+	elapsed := time.Since(r.began)
+	return r.start.Add(time.Duration(float64(elapsed) * r.speed)).Local()
+}
+
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	list := r.entries[replayKey(req.URL.String())]
+	if len(list) == 0 {
+		return replayResponse(req, http.StatusNotFound, nil), nil
+	}
+
+	// Latest response at or before now, or the first one if the
+	// request comes before it was originally made
+	now := r.Now()
+	entry := list[0]
+	for _, e := range list {
+		if e.Time.After(now) {
+			break
+		}
+		entry = e
+	}
+
+	body, err := os.ReadFile(filepath.Join(r.dir, entry.File))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recorded response: %w", err)
+	}
+	return replayResponse(req, entry.Status, body), nil
+}
+
+func replayResponse(req *http.Request, status int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// Recordings are matched on path and query so they replay against any host
+func replayKey(url string) string {
+	if i := strings.Index(url, "://"); i >= 0 {
+		url = url[i+3:]
+		if j := strings.Index(url, "/"); j >= 0 {
+			return url[j:]
+		}
+		return "/"
+	}
+	return url
+}
+
+// Short label for a recorded file name
+func endpointKind(url string) string {
+	switch {
+	case strings.Contains(url, "/scoreboard"):
+		return "scoreboard"
+	case strings.Contains(url, "/odds"):
+		return "odds"
+	case strings.Contains(url, "/summary"):
+		return "summary"
+	case strings.Contains(url, "/plays"):
+		return "plays"
+	case strings.Contains(url, "/standings"):
+		return "standings"
+	case strings.Contains(url, "/schedule"):
+		return "schedule"
+	case strings.Contains(url, "/injuries"):
+		return "injuries"
+	default:
+		return "response"
+	}
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Returned without touching the network while an endpoint's breaker is open
+var ErrCircuitOpen = errors.New("circuit open, endpoint failing")
+
+// Non-200 response from the API
+type StatusError struct {
+	Code int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("API request failed with status: %d", e.Code)
+}
+
+type RetryPolicy struct {
+	// Attempts per request, including the first one
+	MaxAttempts int
+	// Backoff before the first retry, doubled for each one after
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// Consecutive failed requests that open an endpoint's breaker, and how
+	// long it stays open before a trial request is let through
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:      3,
+	BaseDelay:        250 * time.Millisecond,
+	MaxDelay:         4 * time.Second,
+	BreakerThreshold: 5,
+	BreakerCooldown:  30 * time.Second,
+}
+
+// Fills in every field that is zero or less from DefaultRetryPolicy, so a
+// partial policy still makes at least one attempt and lets requests through
+func (r RetryPolicy) withDefaults() RetryPolicy {
+	if r.MaxAttempts <= 0 {
+		r.MaxAttempts = DefaultRetryPolicy.MaxAttempts
+	}
+	if r.BaseDelay <= 0 {
+		r.BaseDelay = DefaultRetryPolicy.BaseDelay
+	}
+	if r.MaxDelay <= 0 {
+		r.MaxDelay = DefaultRetryPolicy.MaxDelay
+	}
+	if r.BreakerThreshold <= 0 {
+		r.BreakerThreshold = DefaultRetryPolicy.BreakerThreshold
+	}
+	if r.BreakerCooldown <= 0 {
+		r.BreakerCooldown = DefaultRetryPolicy.BreakerCooldown
+	}
+	return r
+}
+
+// Exponential backoff with jitter, so terminals that failed together don't
+// retry together
+func (r RetryPolicy) backoff(attempt int) time.Duration {
+	delay := r.BaseDelay << (attempt - 1)
+	if delay <= 0 || delay > r.MaxDelay {
+		delay = r.MaxDelay
+	}
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+// Network errors, throttling and server errors are worth another try,
+// anything else will fail the same way again
+func retryable(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code == http.StatusTooManyRequests || statusErr.Code >= 500
+	}
+	return true
+}
+
+// Time until the context's deadline, ok is false when it has none
+func timeLeft(ctx context.Context) (time.Duration, bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0, false
+	}
+	return time.Until(deadline), true
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Stops requests to an endpoint after repeated failures, letting a single
+// trial request through once the cooldown has passed
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	trial     bool
+}
+
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+	if time.Now().Before(b.openUntil) || b.trial {
+		return false
+	}
+	b.trial = true
+	return true
+}
+
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.trial = false
+}
+
+func (b *breaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	b.trial = false
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+	}
+}
+
+// Releases a trial request that was cancelled before it got an answer
+func (b *breaker) abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trial = false
+}
+
+// Circuit breakers keyed by endpoint
+type breakers struct {
+	mu     sync.Mutex
+	policy RetryPolicy
+	byName map[string]*breaker
+}
+
+func newBreakers(policy RetryPolicy) *breakers {
+	return &breakers{policy: policy, byName: make(map[string]*breaker)}
+}
+
+func (b *breakers) get(endpoint string) *breaker {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	br, ok := b.byName[endpoint]
+	if !ok {
+		br = &breaker{threshold: b.policy.BreakerThreshold, cooldown: b.policy.BreakerCooldown}
+		b.byName[endpoint] = br
+	}
+	return br
+}
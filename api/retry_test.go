@@ -0,0 +1,44 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyWithDefaults(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		want   RetryPolicy
+	}{
+		{"zero", RetryPolicy{}, DefaultRetryPolicy},
+		{
+			"partial",
+			RetryPolicy{BaseDelay: time.Second},
+			RetryPolicy{
+				MaxAttempts:      DefaultRetryPolicy.MaxAttempts,
+				BaseDelay:        time.Second,
+				MaxDelay:         DefaultRetryPolicy.MaxDelay,
+				BreakerThreshold: DefaultRetryPolicy.BreakerThreshold,
+				BreakerCooldown:  DefaultRetryPolicy.BreakerCooldown,
+			},
+		},
+		{
+			"negative",
+			RetryPolicy{MaxAttempts: -1, BreakerThreshold: -1},
+			DefaultRetryPolicy,
+		},
+		{
+			"complete",
+			RetryPolicy{MaxAttempts: 1, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, BreakerThreshold: 1, BreakerCooldown: time.Second},
+			RetryPolicy{MaxAttempts: 1, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, BreakerThreshold: 1, BreakerCooldown: time.Second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.withDefaults(); got != tt.want {
+				t.Errorf("withDefaults() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const scheduleTTL = 10 * time.Minute
+
+// A team's games for the season, played and upcoming, in date order
+type TeamSchedule struct {
+	TeamID string
+	Team   string
+	League string
+	Games  []Game
+}
+
+// Optional provider capability for team schedules
+type ScheduleProvider interface {
+	GetTeamSchedule(ctx context.Context, league, teamID string) (*TeamSchedule, error)
+}
+
+var _ ScheduleProvider = (*ESPNProvider)(nil)
+
+// Schedule events look like scoreboard events, except scores are objects and
+// the status lives on the competition
+type espnSchedule struct {
+	Team struct {
+		ID          string `json:"id"`
+		DisplayName string `json:"displayName"`
+	} `json:"team"`
+	Events []struct {
+		ID           string `json:"id"`
+		Date         string `json:"date"`
+		Competitions []struct {
+			ID     string `json:"id"`
+			Status struct {
+				Period int `json:"period"`
+				Type   struct {
+					Name        string `json:"name"`
+					State       string `json:"state"`
+					Completed   bool   `json:"completed"`
+					Description string `json:"description"`
+					Detail      string `json:"detail"`
+				} `json:"type"`
+			} `json:"status"`
+			Competitors []struct {
+				HomeAway string   `json:"homeAway"`
+				Team     espnTeam `json:"team"`
+				Score    struct {
+					Value float64 `json:"value"`
+				} `json:"score"`
+				ShootoutScore int `json:"shootoutScore"`
+			} `json:"competitors"`
+		} `json:"competitions"`
+	} `json:"events"`
+}
+
+// Fetches a team's schedule and results for the current season
+func (p *ESPNProvider) GetTeamSchedule(ctx context.Context, name, teamID string) (*TeamSchedule, error) {
+	league, ok := LookupLeague(name)
+	if !ok {
+		return nil, fmt.Errorf("unsupported league: %s", name)
+	}
+	url := fmt.Sprintf("%s/apis/site/v2/sports/%s/%s/teams/%s/schedule", p.siteURL, league.Sport, league.Path, teamID)
+
+	body, err := p.get(ctx, "schedule/"+league.ID, url, scheduleTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw espnSchedule
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse schedule JSON: %w", err)
+	}
+
+	schedule := &TeamSchedule{TeamID: teamID, Team: raw.Team.DisplayName, League: league.Name}
+	for _, event := range raw.Events {
+		if len(event.Competitions) == 0 || len(event.Competitions[0].Competitors) < 2 {
+			continue
+		}
+		comp := event.Competitions[0]
+		status := comp.Status
+
+		game := Game{
+			EventID:       event.ID,
+			CompetitionID: comp.ID,
+			StartTime:     parseEventTime(event.Date),
+			League:        league.Name,
+			State:         parseGameState(status.Type.Name, status.Type.State, status.Type.Completed),
+			StatusDetail:  statusDetail(status.Type.Detail, status.Type.Description),
+			Period:        league.PeriodLabel(status.Period),
+		}
+		if game.CompetitionID == "" {
+			game.CompetitionID = game.EventID
+		}
+
+		for _, competitor := range comp.Competitors {
+			if competitor.HomeAway == "home" {
+				game.HomeTeam = competitor.Team.DisplayName
+				game.HomeTeamID = competitor.Team.ID
+				game.HomeScore = int(competitor.Score.Value)
+				game.HomeShootout = competitor.ShootoutScore
+			} else {
+				game.AwayTeam = competitor.Team.DisplayName
+				game.AwayTeamID = competitor.Team.ID
+				game.AwayScore = int(competitor.Score.Value)
+				game.AwayShootout = competitor.ShootoutScore
+			}
+		}
+		schedule.Games = append(schedule.Games, game)
+	}
+
+	sort.Slice(schedule.Games, func(i, j int) bool {
+		return schedule.Games[i].StartTime.Before(schedule.Games[j].StartTime)
+	})
+	return schedule, nil
+}
+
+// Up to n most recent finished games, newest first
+func (s TeamSchedule) Last(n int) []Game {
+	var games []Game
+	for i := len(s.Games) - 1; i >= 0 && len(games) < n; i-- {
+		if s.Games[i].State == Final {
+			games = append(games, s.Games[i])
+		}
+	}
+	return games
+}
+
+// Up to n games that haven't finished yet, soonest first. A game in
+// progress counts as the next one.
+func (s TeamSchedule) Next(n int) []Game {
+	var games []Game
+	for _, game := range s.Games {
+		if len(games) == n {
+			break
+		}
+		if !game.State.IsFinished() {
+			games = append(games, game)
+		}
+	}
+	return games
+}
+
+// "W", "L" or "T" for a finished game from the given team's side, empty
+// if the team didn't play in it or it isn't over
+func (g Game) ResultFor(teamID string) string {
+	if g.State != Final {
+		return ""
+	}
+
+	var own, other int
+	switch teamID {
+	case g.HomeTeamID:
+		own, other = g.HomeScore, g.AwayScore
+	case g.AwayTeamID:
+		own, other = g.AwayScore, g.HomeScore
+	default:
+		return ""
+	}
+
+	// Shootouts decide the winner without changing the score
+	if own == other {
+		if teamID == g.HomeTeamID {
+			own, other = g.HomeShootout, g.AwayShootout
+		} else {
+			own, other = g.AwayShootout, g.HomeShootout
+		}
+	}
+
+	switch {
+	case own > other:
+		return "W"
+	case own < other:
+		return "L"
+	default:
+		return "T"
+	}
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const standingsTTL = 10 * time.Minute
+
+// League table split into ESPN's groups (conferences, divisions, tables)
+type Standings struct {
+	League string
+	Groups []StandingsGroup
+}
+
+type StandingsGroup struct {
+	Name         string
+	Abbreviation string
+	Entries      []StandingsEntry
+}
+
+type StandingsEntry struct {
+	TeamID       string
+	Team         string
+	Abbreviation string
+	Wins         int
+	Losses       int
+	Ties         int
+	OTLosses     int
+	WinPct       float64
+	// Display values as ESPN formats them, e.g. "-" or "2.5" games back
+	GamesBehind string
+	Streak      string
+	// Clinch marker such as "x", "y", "z" or "e", empty when undecided
+	Clincher string
+}
+
+// Record as W-L, with ties or overtime losses when the league has them
+func (e StandingsEntry) Record() string {
+	switch {
+	case e.OTLosses > 0:
+		return fmt.Sprintf("%d-%d-%d", e.Wins, e.Losses, e.OTLosses)
+	case e.Ties > 0:
+		return fmt.Sprintf("%d-%d-%d", e.Wins, e.Losses, e.Ties)
+	default:
+		return fmt.Sprintf("%d-%d", e.Wins, e.Losses)
+	}
+}
+
+// Optional provider capability for league standings
+type StandingsProvider interface {
+	GetStandings(ctx context.Context, league string) (*Standings, error)
+}
+
+var _ StandingsProvider = (*ESPNProvider)(nil)
+
+// Standings nest groups inside groups; only the leaves carry entries
+type espnStandingsNode struct {
+	Name         string              `json:"name"`
+	Abbreviation string              `json:"abbreviation"`
+	Children     []espnStandingsNode `json:"children"`
+	Standings    struct {
+		Entries []struct {
+			Team  espnTeam `json:"team"`
+			Stats []struct {
+				Name         string  `json:"name"`
+				Value        float64 `json:"value"`
+				DisplayValue string  `json:"displayValue"`
+			} `json:"stats"`
+		} `json:"entries"`
+	} `json:"standings"`
+}
+
+// Fetches the current standings for a league
+func (p *ESPNProvider) GetStandings(ctx context.Context, name string) (*Standings, error) {
+	league, ok := LookupLeague(name)
+	if !ok {
+		return nil, fmt.Errorf("unsupported league: %s", name)
+	}
+	url := fmt.Sprintf("%s/apis/v2/sports/%s/%s/standings", p.siteURL, league.Sport, league.Path)
+
+	body, err := p.get(ctx, "standings/"+league.ID, url, standingsTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	var root espnStandingsNode
+	if err := json.Unmarshal(body, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse standings JSON: %w", err)
+	}
+
+	standings := &Standings{League: league.Name}
+	collectStandings(root, &standings.Groups)
+	return standings, nil
+}
+
+func collectStandings(node espnStandingsNode, groups *[]StandingsGroup) {
+	if len(node.Standings.Entries) > 0 {
+		group := StandingsGroup{Name: node.Name, Abbreviation: node.Abbreviation}
+		for _, raw := range node.Standings.Entries {
+			entry := StandingsEntry{
+				TeamID:       raw.Team.ID,
+				Team:         raw.Team.DisplayName,
+				Abbreviation: raw.Team.Abbreviation,
+			}
+			for _, stat := range raw.Stats {
+				switch stat.Name {
+				case "wins":
+					entry.Wins = int(stat.Value)
+				case "losses":
+					entry.Losses = int(stat.Value)
+				case "ties":
+					entry.Ties = int(stat.Value)
+				case "otLosses", "overtimeLosses":
+					entry.OTLosses = int(stat.Value)
+				case "winPercent":
+					entry.WinPct = stat.Value
+				case "gamesBehind":
+					entry.GamesBehind = stat.DisplayValue
+				case "streak":
+					entry.Streak = stat.DisplayValue
+				case "clincher":
+					entry.Clincher = stat.DisplayValue
+				}
+			}
+			group.Entries = append(group.Entries, entry)
+		}
+		*groups = append(*groups, group)
+	}
+
+	for _, child := range node.Children {
+		collectStandings(child, groups)
+	}
+}
+
+// Win percentage the way standings usually print it, e.g. ".625"
+func FormatWinPct(pct float64) string {
+	s := strconv.FormatFloat(pct, 'f', 3, 64)
+	if pct < 1 && len(s) > 1 && s[0] == '0' {
+		return s[1:]
+	}
+	return s
+}
@@ -0,0 +1,87 @@
+package api
+
+import "strings"
+
+// Where a game is in its lifecycle, parsed from ESPN's status type
+type GameState int
+
+const (
+	Scheduled GameState = iota
+	InProgress
+	Halftime
+	EndOfPeriod
+	Delayed
+	Suspended
+	Final
+	Postponed
+	Canceled
+)
+
+var gameStateNames = map[GameState]string{
+	Scheduled:   "Scheduled",
+	InProgress:  "In Progress",
+	Halftime:    "Halftime",
+	EndOfPeriod: "End of Period",
+	Delayed:     "Delayed",
+	Suspended:   "Suspended",
+	Final:       "Final",
+	Postponed:   "Postponed",
+	Canceled:    "Canceled",
+}
+
+func (s GameState) String() string {
+	if name, ok := gameStateNames[s]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// Game has started and hasn't finished, including breaks and delays
+func (s GameState) IsLive() bool {
+	switch s {
+	case InProgress, Halftime, EndOfPeriod, Delayed:
+		return true
+	}
+	return false
+}
+
+// Game is over for the day, whether it was played out or not
+func (s GameState) IsFinished() bool {
+	switch s {
+	case Final, Suspended, Postponed, Canceled:
+		return true
+	}
+	return false
+}
+
+// Maps ESPN's status.type fields onto a GameState. Known names are matched
+// first; anything else falls back on the pre/in/post state and the
+// completed flag, so new ESPN statuses still land somewhere sensible.
+func parseGameState(name, state string, completed bool) GameState {
+	switch name {
+	case "STATUS_HALFTIME", "STATUS_HALFTIME_ET":
+		return Halftime
+	case "STATUS_END_PERIOD", "STATUS_END_OF_REGULATION", "STATUS_END_OF_EXTRATIME":
+		return EndOfPeriod
+	case "STATUS_SUSPENDED":
+		return Suspended
+	case "STATUS_POSTPONED":
+		return Postponed
+	case "STATUS_CANCELED", "STATUS_CANCELLED", "STATUS_ABANDONED":
+		return Canceled
+	}
+
+	// Delays before the start count too, the game is due and not under way
+	if strings.Contains(name, "DELAY") {
+		return Delayed
+	}
+
+	switch {
+	case completed || state == "post":
+		return Final
+	case state == "in":
+		return InProgress
+	default:
+		return Scheduled
+	}
+}
@@ -0,0 +1,43 @@
+package api
+
+import "testing"
+
+func TestParseGameState(t *testing.T) {
+	tests := []struct {
+		name      string
+		typeName  string
+		state     string
+		completed bool
+		want      GameState
+	}{
+		{"scheduled", "STATUS_SCHEDULED", "pre", false, Scheduled},
+		{"in progress", "STATUS_IN_PROGRESS", "in", false, InProgress},
+		{"rain delay", "STATUS_RAIN_DELAY", "in", false, Delayed},
+		{"delayed before the start", "STATUS_DELAYED", "pre", false, Delayed},
+		{"halftime", "STATUS_HALFTIME", "in", false, Halftime},
+		{"extra time halftime", "STATUS_HALFTIME_ET", "in", false, Halftime},
+		{"end of period", "STATUS_END_PERIOD", "in", false, EndOfPeriod},
+		{"end of regulation", "STATUS_END_OF_REGULATION", "in", false, EndOfPeriod},
+		{"final", "STATUS_FINAL", "post", true, Final},
+		{"4OT final", "STATUS_FINAL_OT", "post", true, Final},
+		{"final after penalties", "STATUS_FINAL_PEN", "post", true, Final},
+		{"suspended", "STATUS_SUSPENDED", "in", false, Suspended},
+		{"postponed", "STATUS_POSTPONED", "post", false, Postponed},
+		{"canceled", "STATUS_CANCELED", "post", false, Canceled},
+		{"cancelled", "STATUS_CANCELLED", "post", false, Canceled},
+		{"abandoned", "STATUS_ABANDONED", "post", true, Canceled},
+		{"unknown before the start", "STATUS_SOMETHING_NEW", "pre", false, Scheduled},
+		{"unknown while playing", "STATUS_SOMETHING_NEW", "in", false, InProgress},
+		{"unknown after the end", "STATUS_SOMETHING_NEW", "post", false, Final},
+		{"unknown but completed", "STATUS_SOMETHING_NEW", "in", true, Final},
+		{"empty", "", "", false, Scheduled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseGameState(tt.typeName, tt.state, tt.completed)
+			if got != tt.want {
+				t.Errorf("parseGameState(%q, %q, %v) = %v, want %v", tt.typeName, tt.state, tt.completed, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	liveSummaryTTL     = 15 * time.Second
+	finishedSummaryTTL = 10 * time.Minute
+)
+
+// Everything past the score for a single event
+type GameSummary struct {
+	EventID      string
+	Venue        string
+	Teams        []TeamBox
+	Players      []PlayerStatGroup
+	Leaders      []LeaderCategory
+	ScoringPlays []ScoringPlay
+}
+
+// One side of the box score
+type TeamBox struct {
+	TeamID       string
+	Team         string
+	Abbreviation string
+	HomeAway     string
+	// Score per period, as ESPN displays it
+	Linescore []string
+	Stats     []Stat
+}
+
+type Stat struct {
+	Name  string
+	Label string
+	Value string
+}
+
+// A table of player stats for one team, e.g. an NBA box score or NFL passing
+type PlayerStatGroup struct {
+	TeamID   string
+	Team     string
+	Category string
+	Labels   []string
+	Players  []PlayerLine
+}
+
+type PlayerLine struct {
+	Name     string
+	Position string
+	Stats    []string
+}
+
+// A team's leaders in one category, e.g. points or passing yards
+type LeaderCategory struct {
+	TeamID   string
+	Team     string
+	Category string
+	Leaders  []Leader
+}
+
+type Leader struct {
+	Name  string
+	Value string
+}
+
+type ScoringPlay struct {
+	ID        string
+	Type      string
+	Text      string
+	Period    int
+	Clock     string
+	TeamID    string
+	Team      string
+	AwayScore int
+	HomeScore int
+}
+
+// Optional provider capability for per-event summaries
+type SummaryProvider interface {
+	GetSummary(ctx context.Context, game Game) (*GameSummary, error)
+}
+
+var _ SummaryProvider = (*ESPNProvider)(nil)
+
+type espnTeam struct {
+	ID           string `json:"id"`
+	DisplayName  string `json:"displayName"`
+	Abbreviation string `json:"abbreviation"`
+}
+
+// Team stats are flat for most sports but grouped (batting, pitching) for
+// baseball, so a stat may carry nested stats instead of a value
+type espnStat struct {
+	Name         string     `json:"name"`
+	Label        string     `json:"label"`
+	DisplayValue string     `json:"displayValue"`
+	Stats        []espnStat `json:"stats"`
+}
+
+type espnSummary struct {
+	Header struct {
+		Competitions []struct {
+			Competitors []struct {
+				HomeAway   string   `json:"homeAway"`
+				Team       espnTeam `json:"team"`
+				Linescores []struct {
+					DisplayValue string `json:"displayValue"`
+				} `json:"linescores"`
+			} `json:"competitors"`
+		} `json:"competitions"`
+	} `json:"header"`
+	GameInfo struct {
+		Venue struct {
+			FullName string `json:"fullName"`
+			Address  struct {
+				City  string `json:"city"`
+				State string `json:"state"`
+			} `json:"address"`
+		} `json:"venue"`
+	} `json:"gameInfo"`
+	Boxscore struct {
+		Teams []struct {
+			Team       espnTeam   `json:"team"`
+			HomeAway   string     `json:"homeAway"`
+			Statistics []espnStat `json:"statistics"`
+		} `json:"teams"`
+		Players []struct {
+			Team       espnTeam `json:"team"`
+			Statistics []struct {
+				Name     string   `json:"name"`
+				Text     string   `json:"text"`
+				Labels   []string `json:"labels"`
+				Athletes []struct {
+					Athlete struct {
+						DisplayName string `json:"displayName"`
+						Position    struct {
+							Abbreviation string `json:"abbreviation"`
+						} `json:"position"`
+					} `json:"athlete"`
+					Stats []string `json:"stats"`
+				} `json:"athletes"`
+			} `json:"statistics"`
+		} `json:"players"`
+	} `json:"boxscore"`
+	Leaders []struct {
+		Team    espnTeam `json:"team"`
+		Leaders []struct {
+			Name        string `json:"name"`
+			DisplayName string `json:"displayName"`
+			Leaders     []struct {
+				DisplayValue string `json:"displayValue"`
+				Athlete      struct {
+					DisplayName string `json:"displayName"`
+				} `json:"athlete"`
+			} `json:"leaders"`
+		} `json:"leaders"`
+	} `json:"leaders"`
+	ScoringPlays []struct {
+		ID   string `json:"id"`
+		Type struct {
+			Text string `json:"text"`
+		} `json:"type"`
+		Text      string `json:"text"`
+		AwayScore int    `json:"awayScore"`
+		HomeScore int    `json:"homeScore"`
+		Period    struct {
+			Number int `json:"number"`
+		} `json:"period"`
+		Clock struct {
+			DisplayValue string `json:"displayValue"`
+		} `json:"clock"`
+		Team espnTeam `json:"team"`
+	} `json:"scoringPlays"`
+}
+
+// Fetches the box score, team stats, leaders and scoring plays for a game
+func (p *ESPNProvider) GetSummary(ctx context.Context, game Game) (*GameSummary, error) {
+	league, ok := LookupLeague(game.League)
+	if !ok {
+		return nil, fmt.Errorf("unsupported league: %s", game.League)
+	}
+	url := fmt.Sprintf("%s/apis/site/v2/sports/%s/%s/summary?event=%s", p.siteURL, league.Sport, league.Path, game.EventID)
+
+	ttl := liveSummaryTTL
+	if game.State.IsFinished() {
+		ttl = finishedSummaryTTL
+	}
+
+	body, err := p.get(ctx, "summary/"+league.ID, url, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw espnSummary
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse summary JSON: %w", err)
+	}
+	return raw.toSummary(game.EventID), nil
+}
+
+func (raw espnSummary) toSummary(eventID string) *GameSummary {
+	summary := &GameSummary{EventID: eventID}
+
+	venue := raw.GameInfo.Venue
+	summary.Venue = venue.FullName
+	if venue.Address.City != "" {
+		summary.Venue += ", " + venue.Address.City
+		if venue.Address.State != "" {
+			summary.Venue += ", " + venue.Address.State
+		}
+	}
+
+	// Linescores only come with the header, match them up by team
+	linescores := make(map[string][]string)
+	if len(raw.Header.Competitions) > 0 {
+		for _, competitor := range raw.Header.Competitions[0].Competitors {
+			for _, line := range competitor.Linescores {
+				linescores[competitor.Team.ID] = append(linescores[competitor.Team.ID], line.DisplayValue)
+			}
+		}
+	}
+
+	for _, team := range raw.Boxscore.Teams {
+		summary.Teams = append(summary.Teams, TeamBox{
+			TeamID:       team.Team.ID,
+			Team:         team.Team.DisplayName,
+			Abbreviation: team.Team.Abbreviation,
+			HomeAway:     team.HomeAway,
+			Linescore:    linescores[team.Team.ID],
+			Stats:        flattenStats(team.Statistics),
+		})
+	}
+
+	for _, team := range raw.Boxscore.Players {
+		for _, group := range team.Statistics {
+			category := group.Text
+			if category == "" {
+				category = group.Name
+			}
+			stats := PlayerStatGroup{
+				TeamID:   team.Team.ID,
+				Team:     team.Team.DisplayName,
+				Category: category,
+				Labels:   group.Labels,
+			}
+			for _, athlete := range group.Athletes {
+				stats.Players = append(stats.Players, PlayerLine{
+					Name:     athlete.Athlete.DisplayName,
+					Position: athlete.Athlete.Position.Abbreviation,
+					Stats:    athlete.Stats,
+				})
+			}
+			summary.Players = append(summary.Players, stats)
+		}
+	}
+
+	for _, team := range raw.Leaders {
+		for _, category := range team.Leaders {
+			leaders := LeaderCategory{
+				TeamID:   team.Team.ID,
+				Team:     team.Team.DisplayName,
+				Category: category.DisplayName,
+			}
+			for _, leader := range category.Leaders {
+				leaders.Leaders = append(leaders.Leaders, Leader{
+					Name:  leader.Athlete.DisplayName,
+					Value: leader.DisplayValue,
+				})
+			}
+			summary.Leaders = append(summary.Leaders, leaders)
+		}
+	}
+
+	for _, play := range raw.ScoringPlays {
+		summary.ScoringPlays = append(summary.ScoringPlays, ScoringPlay{
+			ID:        play.ID,
+			Type:      play.Type.Text,
+			Text:      play.Text,
+			Period:    play.Period.Number,
+			Clock:     play.Clock.DisplayValue,
+			TeamID:    play.Team.ID,
+			Team:      play.Team.DisplayName,
+			AwayScore: play.AwayScore,
+			HomeScore: play.HomeScore,
+		})
+	}
+
+	return summary
+}
+
+func flattenStats(raw []espnStat) []Stat {
+	var stats []Stat
+	for _, stat := range raw {
+		if len(stat.Stats) > 0 {
+			stats = append(stats, flattenStats(stat.Stats)...)
+			continue
+		}
+		stats = append(stats, Stat{Name: stat.Name, Label: stat.Label, Value: stat.DisplayValue})
+	}
+	return stats
+}
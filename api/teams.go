@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const teamsTTL = 24 * time.Hour
+
+// A team in a league's team list
+type Team struct {
+	ID           string
+	Name         string
+	Abbreviation string
+}
+
+// Optional provider capability for listing a league's teams
+type TeamsProvider interface {
+	GetTeams(ctx context.Context, league string) ([]Team, error)
+}
+
+var _ TeamsProvider = (*ESPNProvider)(nil)
+
+type espnTeams struct {
+	Sports []struct {
+		Leagues []struct {
+			Teams []struct {
+				Team espnTeam `json:"team"`
+			} `json:"teams"`
+		} `json:"leagues"`
+	} `json:"sports"`
+}
+
+// Fetches every team in a league
+func (p *ESPNProvider) GetTeams(ctx context.Context, name string) ([]Team, error) {
+	league, ok := LookupLeague(name)
+	if !ok {
+		return nil, fmt.Errorf("unsupported league: %s", name)
+	}
+	url := fmt.Sprintf("%s/apis/site/v2/sports/%s/%s/teams", p.siteURL, league.Sport, league.Path)
+
+	body, err := p.get(ctx, "teams/"+league.ID, url, teamsTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw espnTeams
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse teams JSON: %w", err)
+	}
+
+	var teams []Team
+	for _, sport := range raw.Sports {
+		for _, l := range sport.Leagues {
+			for _, entry := range l.Teams {
+				teams = append(teams, Team{
+					ID:           entry.Team.ID,
+					Name:         entry.Team.DisplayName,
+					Abbreviation: entry.Team.Abbreviation,
+				})
+			}
+		}
+	}
+	return teams, nil
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mcbk51/scores_dash/api"
+	"github.com/rivo/tview"
+)
+
+// Page comparing every book's line for each open game, best numbers in green
+type BooksView struct {
+	app     *tview.Application
+	view    *tview.TextView
+	display *Display
+}
+
+func NewBooksView(app *tview.Application, view *tview.TextView, display *Display) *BooksView {
+	return &BooksView{
+		app:     app,
+		view:    view,
+		display: display,
+	}
+}
+
+// Renders the books for live and upcoming games from the last refresh,
+// including idle leagues' next games
+func (b *BooksView) Output() {
+	b.view.Clear()
+	fmt.Fprintf(b.view, "[yellow]=== Odds Comparison ===[-] [gray]best line per side in [green]green[gray] | o back[-]\n\n")
+
+	shown := 0
+	for _, game := range b.display.Games() {
+		if game.State.IsFinished() || len(game.Books) == 0 {
+			continue
+		}
+		renderBooks(b.view, game)
+		shown++
+	}
+	if shown == 0 {
+		fmt.Fprintf(b.view, "[gray]No lines available[-]\n")
+	}
+	b.view.ScrollToBeginning()
+	b.app.Draw()
+}
+
+func renderBooks(view *tview.TextView, game api.Game) {
+	best := api.FindBestLines(game.Books)
+	hasDraw := best.DrawMoneyLine >= 0
+	// A lone book has nothing to compare against
+	compare := len(game.Books) > 1
+
+	width := len("Book")
+	for _, book := range game.Books {
+		width = max(width, len(book.Provider))
+	}
+
+	status := game.StatusDetail
+	if status == "" {
+		status = game.State.String()
+	}
+	fmt.Fprintf(view, "[%s]▼ %s[-] %s @ %s [gray]{%s}[-]\n", leagueColor(game.League), game.League, game.AwayTeam, game.HomeTeam, status)
+
+	header := fmt.Sprintf("   %-*s %13s %13s %13s %13s %8s %8s", width, "Book", "Away", "Home", "Over", "Under", "Away ML", "Home ML")
+	if hasDraw {
+		header += fmt.Sprintf(" %8s", "Draw")
+	}
+	fmt.Fprintf(view, "[gray]%s[-]\n", header)
+
+	for i, book := range game.Books {
+		row := []string{
+			bookCell(13, compare && i == best.AwaySpread, formatLine(book.HasSpread(), book.AwaySpread, book.AwaySpreadOdds, "%+.1f")),
+			bookCell(13, compare && i == best.HomeSpread, formatLine(book.HasSpread(), book.HomeSpread, book.HomeSpreadOdds, "%+.1f")),
+			bookCell(13, compare && i == best.Over, formatLine(book.HasTotal(), book.Total, book.OverOdds, "o%.1f")),
+			bookCell(13, compare && i == best.Under, formatLine(book.HasTotal(), book.Total, book.UnderOdds, "u%.1f")),
+			bookCell(8, compare && i == best.AwayMoneyLine, formatMoneyLine(book.AwayMoneyLine)),
+			bookCell(8, compare && i == best.HomeMoneyLine, formatMoneyLine(book.HomeMoneyLine)),
+		}
+		if hasDraw {
+			row = append(row, bookCell(8, compare && i == best.DrawMoneyLine, formatMoneyLine(book.DrawMoneyLine)))
+		}
+		fmt.Fprintf(view, "   %-*s %s\n", width, book.Provider, strings.Join(row, " "))
+	}
+	fmt.Fprintf(view, "\n")
+}
+
+// Pads before coloring so the tags don't throw off the column width
+func bookCell(width int, best bool, text string) string {
+	cell := fmt.Sprintf("%*s", width, text)
+	if best {
+		return fmt.Sprintf("[green]%s[-]", cell)
+	}
+	return cell
+}
+
+// A spread or total with its price, e.g. "+3.5 (-110)"
+func formatLine(ok bool, line float64, price int, format string) string {
+	if !ok {
+		return "-"
+	}
+	text := fmt.Sprintf(format, line)
+	if price != 0 {
+		text += fmt.Sprintf(" (%s)", formatMoneyLine(price))
+	}
+	return text
+}
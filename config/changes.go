@@ -0,0 +1,120 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mcbk51/scores_dash/api"
+)
+
+// How long a game stays highlighted after its score or status changes, and
+// the background it is highlighted with
+const (
+	changeHighlight  = 8 * time.Second
+	changeBackground = "#5f5f00"
+)
+
+// Points each side scored since the previous refresh, and until when the
+// game is highlighted for it. Period and status changes have no points.
+type scoreChange struct {
+	away  int
+	home  int
+	until time.Time
+}
+
+// Compares a game with how it looked on the previous refresh. The clock is
+// left out since it changes on every refresh of a live game, and so is the
+// status detail while play is on, which carries the clock too.
+func gameChanged(previous, current api.Game) bool {
+	switch {
+	case previous.AwayScore != current.AwayScore || previous.HomeScore != current.HomeScore:
+		return true
+	case previous.State != current.State || previous.Period != current.Period:
+		return true
+	case current.State != api.InProgress && previous.StatusDetail != current.StatusDetail:
+		return true
+	}
+	return false
+}
+
+// Remembers this refresh's games and highlights every one that changed
+// since the last, scheduling a redraw for when the highlights run out
+func (d *Display) trackChanges(games []api.Game) {
+	now := time.Now()
+
+	d.mu.Lock()
+	for eventID, change := range d.changes {
+		if !now.Before(change.until) {
+			delete(d.changes, eventID)
+		}
+	}
+
+	changed := false
+	current := make(map[string]api.Game, len(games))
+	for _, game := range games {
+		current[game.EventID] = game
+		previous, ok := d.previous[game.EventID]
+		if !ok || !gameChanged(previous, game) {
+			continue
+		}
+		d.changes[game.EventID] = scoreChange{
+			away:  game.AwayScore - previous.AwayScore,
+			home:  game.HomeScore - previous.HomeScore,
+			until: now.Add(changeHighlight),
+		}
+		changed = true
+	}
+	d.previous = current
+	d.mu.Unlock()
+
+	if changed {
+		time.AfterFunc(changeHighlight, d.layout)
+	}
+}
+
+// Changes still being highlighted
+func (d *Display) activeChanges() map[string]scoreChange {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	active := make(map[string]scoreChange)
+	for eventID, change := range d.changes {
+		if now.Before(change.until) {
+			active[eventID] = change
+		}
+	}
+	return active
+}
+
+// Copy of the board with recently changed games highlighted and the points
+// just scored next to each score
+func (s *scoreboard) withChanges(changes map[string]scoreChange) *table {
+	if len(changes) == 0 {
+		return s.table
+	}
+
+	highlighted := &table{columns: s.columns, rows: make([]tableRow, len(s.rows))}
+	for i, row := range s.rows {
+		change, ok := changes[row.region]
+		if row.cells == nil || !ok {
+			highlighted.rows[i] = row
+			continue
+		}
+		cells := slices.Clone(row.cells)
+		if change.away != 0 {
+			cells[colAwayScore] = formatScoreDelta(change.away) + " " + cells[colAwayScore]
+		}
+		if change.home != 0 {
+			cells[colHomeScore] += " " + formatScoreDelta(change.home)
+		}
+		highlighted.rows[i] = tableRow{cells: cells, region: row.region, background: changeBackground}
+	}
+	return highlighted
+}
+
+// Points scored since the last refresh, e.g. "+3"
+func formatScoreDelta(points int) string {
+	return fmt.Sprintf("[green]%+d[-]", points)
+}
@@ -0,0 +1,200 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rivo/tview"
+)
+
+// Gap between columns. Shrinking columns are cut to shrinkWidth before any
+// column is dropped, and never below minColumnWidth.
+const (
+	columnGap      = 1
+	shrinkWidth    = 16
+	minColumnWidth = 6
+)
+
+// Color and style tags as tview parses them, e.g. "[red]", "[-]", "[::b]"
+var colorTag = regexp.MustCompile(`^\[([a-zA-Z]+|#[0-9a-fA-F]{6}|-)?(:([a-zA-Z]+|#[0-9a-fA-F]{6}|-)?(:([a-zA-Z]+|-)?)?)?\]`)
+
+type tableColumn struct {
+	align int
+
+	// Columns are dropped highest priority first when the view is too
+	// narrow, zero means the column is always shown
+	priority int
+
+	// Column may be cut short with an ellipsis to fit
+	shrink bool
+}
+
+// A row of cells, or a full width line when cells is nil
+type tableRow struct {
+	cells []string
+	line  string
+
+	// Region the row is wrapped in so the view can highlight it, if any
+	region string
+
+	// Background color for the whole row, if any
+	background string
+}
+
+// Lays out rows of tagged cells in aligned columns, with free form lines
+// such as league headers in between. Widths are worked out on render so the
+// same table can be redrawn when the view is resized.
+type table struct {
+	columns []tableColumn
+	rows    []tableRow
+}
+
+func newTable(columns ...tableColumn) *table {
+	return &table{columns: columns}
+}
+
+func (t *table) addRegionRow(region string, cells ...string) {
+	for i := range cells {
+		cells[i] = strings.TrimSpace(cells[i])
+	}
+	t.rows = append(t.rows, tableRow{cells: cells, region: region})
+}
+
+func (t *table) addLine(format string, args ...any) {
+	t.rows = append(t.rows, tableRow{line: fmt.Sprintf(format, args...)})
+}
+
+// Column widths for the given view width, zero for dropped columns. Empty
+// columns are always dropped; a width of zero or less means unlimited.
+func (t *table) widths(width int) []int {
+	widths := make([]int, len(t.columns))
+	for _, row := range t.rows {
+		for i, cell := range row.cells {
+			if i < len(widths) {
+				widths[i] = max(widths[i], tview.TaggedStringWidth(cell))
+			}
+		}
+	}
+	if width <= 0 {
+		return widths
+	}
+
+	total := func(shrunk int) int {
+		sum := 0
+		for i, w := range widths {
+			if w == 0 {
+				continue
+			}
+			if t.columns[i].shrink {
+				w = min(w, shrunk)
+			}
+			sum += w + columnGap
+		}
+		return sum
+	}
+
+	// Drop whole priority levels until the row fits with shrunk columns
+	for total(shrinkWidth) > width {
+		drop := 0
+		for i, column := range t.columns {
+			if widths[i] > 0 {
+				drop = max(drop, column.priority)
+			}
+		}
+		if drop == 0 {
+			break
+		}
+		for i, column := range t.columns {
+			if column.priority == drop {
+				widths[i] = 0
+			}
+		}
+	}
+
+	// Then cut shrinkable columns down, widest first
+	for total(math.MaxInt) > width {
+		widest := -1
+		for i, column := range t.columns {
+			if column.shrink && widths[i] > minColumnWidth && (widest < 0 || widths[i] > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		widths[widest]--
+	}
+	return widths
+}
+
+func (t *table) render(w io.Writer, width int) {
+	widths := t.widths(width)
+	for _, row := range t.rows {
+		if row.cells == nil {
+			fmt.Fprintln(w, row.line)
+			continue
+		}
+		var line strings.Builder
+		for i, cell := range row.cells {
+			if i >= len(widths) || widths[i] == 0 {
+				continue
+			}
+			line.WriteString(" ")
+			line.WriteString(fitCell(cell, widths[i], t.columns[i].align))
+		}
+		text := strings.TrimRight(line.String(), " ")
+		if row.background != "" {
+			text = fmt.Sprintf("[:%s]%s[:-]", row.background, text)
+		}
+		if row.region != "" {
+			text = fmt.Sprintf(`["%s"]%s[""]`, row.region, text)
+		}
+		fmt.Fprintln(w, text)
+	}
+}
+
+// Pads a tagged cell to width, cutting it short with an ellipsis if needed
+func fitCell(cell string, width, align int) string {
+	cellWidth := tview.TaggedStringWidth(cell)
+	if cellWidth > width {
+		// Wide runes may leave the cut one column short of width
+		cell = truncateTagged(cell, width)
+		cellWidth = tview.TaggedStringWidth(cell)
+	}
+
+	pad := width - cellWidth
+	switch align {
+	case tview.AlignRight:
+		return strings.Repeat(" ", pad) + cell
+	case tview.AlignCenter:
+		return strings.Repeat(" ", pad/2) + cell + strings.Repeat(" ", pad-pad/2)
+	}
+	return cell + strings.Repeat(" ", pad)
+}
+
+// Cuts a tagged string to width on screen, keeping its tags intact
+func truncateTagged(text string, width int) string {
+	var out strings.Builder
+	used := 0
+	for len(text) > 0 {
+		if tag := colorTag.FindString(text); tag != "" {
+			out.WriteString(tag)
+			text = text[len(tag):]
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(text)
+		runeWidth := tview.TaggedStringWidth(string(r))
+		if used+runeWidth > width-1 {
+			break
+		}
+		out.WriteString(text[:size])
+		used += runeWidth
+		text = text[size:]
+	}
+	out.WriteString("…[-]")
+	return out.String()
+}
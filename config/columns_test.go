@@ -0,0 +1,128 @@
+package config
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/rivo/tview"
+)
+
+func TestFitCell(t *testing.T) {
+	tests := []struct {
+		name  string
+		cell  string
+		width int
+		align int
+		want  string
+	}{
+		{"pad left", "ab", 4, tview.AlignLeft, "ab  "},
+		{"pad right", "ab", 4, tview.AlignRight, "  ab"},
+		{"pad center", "ab", 5, tview.AlignCenter, " ab  "},
+		{"exact fit", "abcd", 4, tview.AlignLeft, "abcd"},
+		{"tags take no width", "[red]ab[-]", 4, tview.AlignLeft, "[red]ab[-]  "},
+		{"cut with ellipsis", "abcdef", 4, tview.AlignLeft, "abc…[-]"},
+		{"cut keeps leading tag", "[red]abcdef[-]", 4, tview.AlignLeft, "[red]abc…[-]"},
+		{"cut keeps inner tag", "ab[green]cdef", 4, tview.AlignLeft, "ab[green]c…[-]"},
+		{"wide runes padded after cut", "日本語", 4, tview.AlignLeft, "日…[-] "},
+		{"empty", "", 3, tview.AlignRight, "   "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fitCell(tt.cell, tt.width, tt.align)
+			if got != tt.want {
+				t.Errorf("fitCell(%q, %d) = %q, want %q", tt.cell, tt.width, got, tt.want)
+			}
+			if width := tview.TaggedStringWidth(got); width != tt.width {
+				t.Errorf("fitCell(%q, %d) is %d wide", tt.cell, tt.width, width)
+			}
+		})
+	}
+}
+
+func TestTruncateTagged(t *testing.T) {
+	tests := []struct {
+		text  string
+		width int
+		want  string
+	}{
+		{"abcdef", 3, "ab…[-]"},
+		{"abcdef", 1, "…[-]"},
+		{"[::b]abcdef", 3, "[::b]ab…[-]"},
+		{"[#ff0000]abc[-]def", 5, "[#ff0000]abc[-]d…[-]"},
+		{"a[yellow]↗[-]bc", 3, "a[yellow]↗[-]…[-]"},
+	}
+	for _, tt := range tests {
+		got := truncateTagged(tt.text, tt.width)
+		if got != tt.want {
+			t.Errorf("truncateTagged(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+		}
+	}
+}
+
+// Three columns: a long shrinkable name that is always kept, then two
+// fixed columns dropped second and first
+func widthsTable(rows ...[]string) *table {
+	t := newTable(
+		tableColumn{align: tview.AlignLeft, shrink: true},
+		tableColumn{align: tview.AlignLeft, priority: 1},
+		tableColumn{align: tview.AlignLeft, priority: 2},
+	)
+	for _, row := range rows {
+		t.addRegionRow("", row...)
+	}
+	return t
+}
+
+func TestWidths(t *testing.T) {
+	long := strings.Repeat("a", 20)
+	tests := []struct {
+		name  string
+		table *table
+		width int
+		want  []int
+	}{
+		{"unlimited", widthsTable([]string{long, "bbbbb", "ccccccc"}), 0, []int{20, 5, 7}},
+		{"everything fits", widthsTable([]string{long, "bbbbb", "ccccccc"}), 100, []int{20, 5, 7}},
+		{"widest cell wins", widthsTable([]string{"a", "bb", "c"}, []string{"aaa", "b", "cc"}), 100, []int{3, 2, 2}},
+		{"tags take no width", widthsTable([]string{"[red]aa[-]", "[::b]b", "c"}), 100, []int{2, 1, 1}},
+		{"empty column dropped", widthsTable([]string{"aa", "", "c"}), 100, []int{2, 0, 1}},
+		{"short cells ignored", widthsTable([]string{"aa"}), 100, []int{2, 0, 0}},
+		{"highest priority dropped first", widthsTable([]string{long, "bbbbb", "ccccccc"}), 30, []int{20, 5, 0}},
+		{"shrinks before dropping", widthsTable([]string{long, "bbbbb", "ccccccc"}), 32, []int{17, 5, 7}},
+		{"drops then shrinks", widthsTable([]string{long, "bbbbb", "ccccccc"}), 20, []int{19, 0, 0}},
+		{"never below the minimum", widthsTable([]string{long, "bbbbb", "ccccccc"}), 5, []int{minColumnWidth, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.table.widths(tt.width)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("widths(%d) = %v, want %v", tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	table := newTable(
+		tableColumn{align: tview.AlignLeft},
+		tableColumn{align: tview.AlignRight},
+	)
+	table.addLine("[yellow]%s[-]", "header")
+	table.addRegionRow("", "a", "1")
+	table.addRegionRow("7", " bb ", "22")
+	table.rows = append(table.rows, tableRow{cells: []string{"c", ""}, background: "blue"})
+
+	var out strings.Builder
+	table.render(&out, 0)
+	want := strings.Join([]string{
+		"[yellow]header[-]",
+		" a   1",
+		`["7"] bb 22[""]`,
+		"[:blue] c[:-]",
+		"",
+	}, "\n")
+	if out.String() != want {
+		t.Errorf("render =\n%q\nwant\n%q", out.String(), want)
+	}
+}
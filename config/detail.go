@@ -0,0 +1,198 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/mcbk51/scores_dash/api"
+	"github.com/rivo/tview"
+)
+
+// Modal with the linescore, leaders, odds and venue for the selected game
+type DetailView struct {
+	app      *tview.Application
+	view     *tview.TextView
+	provider api.SummaryProvider
+	ctx      context.Context
+
+	mu      sync.Mutex
+	eventID string
+}
+
+func NewDetailView(app *tview.Application, view *tview.TextView, provider api.SummaryProvider, ctx context.Context) *DetailView {
+	return &DetailView{
+		app:      app,
+		view:     view,
+		provider: provider,
+		ctx:      ctx,
+	}
+}
+
+func (d *DetailView) current() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.eventID
+}
+
+// Fetches the game's summary and renders it, odds and all
+func (d *DetailView) Output(game api.Game) {
+	d.mu.Lock()
+	d.eventID = game.EventID
+	d.mu.Unlock()
+
+	d.view.Clear()
+	d.view.SetTitle(fmt.Sprintf(" [%s]%s[-] %s @ %s ", leagueColor(game.League), game.League, game.AwayTeam, game.HomeTeam))
+	fmt.Fprintf(d.view, "[gray]Loading...[-]\n")
+	d.app.Draw()
+
+	ctx, cancel := context.WithTimeout(d.ctx, refreshTimeout)
+	defer cancel()
+
+	summary, err := d.provider.GetSummary(ctx, game)
+	if d.ctx.Err() != nil || d.current() != game.EventID {
+		return
+	}
+
+	d.view.Clear()
+	renderGameHeader(d.view, game)
+	if err != nil {
+		fmt.Fprintf(d.view, "[red]Error fetching summary: %v[-]\n\n", err)
+	} else {
+		if summary.Venue != "" {
+			fmt.Fprintf(d.view, "[gray]%s[-]\n", summary.Venue)
+		}
+		fmt.Fprintf(d.view, "\n")
+		renderLinescore(d.view, game, summary.Teams)
+	}
+	renderGameOdds(d.view, game)
+	if err == nil {
+		renderLeaders(d.view, summary.Leaders)
+	}
+	fmt.Fprintf(d.view, "[gray]Enter or Esc to close[-]\n")
+	d.view.ScrollToBeginning()
+	d.app.Draw()
+}
+
+func renderGameHeader(view *tview.TextView, game api.Game) {
+	status := game.StatusDetail
+	if status == "" {
+		status = game.State.String()
+	}
+	if game.State.IsLive() || game.State.IsFinished() {
+		fmt.Fprintf(view, "[white]%s [purple]%d[-] @ [purple]%d[-] %s[-]\n", game.AwayTeam, game.AwayScore, game.HomeScore, game.HomeTeam)
+	} else {
+		fmt.Fprintf(view, "[white]%s @ %s[-]\n", game.AwayTeam, game.HomeTeam)
+		status = fmt.Sprintf("%s at %s", formatGameDate(game.StartTime), game.StartTime.Local().Format("3:04 PM"))
+	}
+	fmt.Fprintf(view, "[gray]{%s}[-]\n", status)
+}
+
+// Score by period, away team first, with the game's total at the end
+func renderLinescore(view *tview.TextView, game api.Game, teams []api.TeamBox) {
+	periods := 0
+	for _, team := range teams {
+		periods = max(periods, len(team.Linescore))
+	}
+	if periods == 0 {
+		return
+	}
+
+	width := len("Team")
+	for _, team := range teams {
+		width = max(width, len(team.Abbreviation))
+	}
+
+	fmt.Fprintf(view, "[orange]Linescore[-]\n")
+	header := fmt.Sprintf("   %-*s", width, "")
+	for i := 1; i <= periods; i++ {
+		header += fmt.Sprintf(" %3d", i)
+	}
+	fmt.Fprintf(view, "[gray]%s %4s[-]\n", header, "T")
+
+	for _, homeAway := range []string{"away", "home"} {
+		for _, team := range teams {
+			if team.HomeAway != homeAway {
+				continue
+			}
+			total := game.AwayScore
+			if homeAway == "home" {
+				total = game.HomeScore
+			}
+			row := fmt.Sprintf("   %-*s", width, team.Abbreviation)
+			for i := 0; i < periods; i++ {
+				score := "-"
+				if i < len(team.Linescore) {
+					score = team.Linescore[i]
+				}
+				row += fmt.Sprintf(" %3s", score)
+			}
+			fmt.Fprintf(view, "%s [purple]%4d[-]\n", row, total)
+		}
+	}
+	fmt.Fprintf(view, "\n")
+}
+
+// The game's line from its preferred book, one market per row
+func renderGameOdds(view *tview.TextView, game api.Game) {
+	odds := game.Odds
+	if !odds.HasSpread() && !odds.HasTotal() && odds.AwayMoneyLine == 0 && odds.HomeMoneyLine == 0 {
+		return
+	}
+
+	width := max(13, len(game.AwayTeam), len(game.HomeTeam))
+	fmt.Fprintf(view, "[orange]Odds[-] %s\n", formatOddsSource(odds.Provider))
+	header := fmt.Sprintf("   %-9s %*s %*s", "", width, game.AwayTeam, width, game.HomeTeam)
+	if odds.DrawMoneyLine != 0 {
+		header += fmt.Sprintf(" %8s", "Draw")
+	}
+	fmt.Fprintf(view, "[gray]%s[-]\n", header)
+
+	if odds.HasSpread() {
+		fmt.Fprintf(view, "   %-9s %*s %*s\n", "Spread", width,
+			formatLine(true, odds.AwaySpread, odds.AwaySpreadOdds, "%+.1f"), width,
+			formatLine(true, odds.HomeSpread, odds.HomeSpreadOdds, "%+.1f"))
+	}
+	moneyline := fmt.Sprintf("   %-9s %*s %*s", "Moneyline", width, formatMoneyLine(odds.AwayMoneyLine), width, formatMoneyLine(odds.HomeMoneyLine))
+	if odds.DrawMoneyLine != 0 {
+		moneyline += fmt.Sprintf(" %8s", formatMoneyLine(odds.DrawMoneyLine))
+	}
+	fmt.Fprintf(view, "%s\n", moneyline)
+	if odds.HasTotal() {
+		fmt.Fprintf(view, "   %-9s %*s %*s\n", "Total", width,
+			formatLine(true, odds.Total, odds.OverOdds, "o%.1f"), width,
+			formatLine(true, odds.Total, odds.UnderOdds, "u%.1f"))
+	}
+	fmt.Fprintf(view, "\n")
+}
+
+// Each team's leaders by category, e.g. "Passing Yards  Bo Nix 211 YDS"
+func renderLeaders(view *tview.TextView, categories []api.LeaderCategory) {
+	if len(categories) == 0 {
+		return
+	}
+
+	width := 0
+	for _, category := range categories {
+		width = max(width, len(category.Category))
+	}
+
+	fmt.Fprintf(view, "[orange]Leaders[-]\n")
+	team := ""
+	for _, category := range categories {
+		if len(category.Leaders) == 0 {
+			continue
+		}
+		if category.Team != team {
+			team = category.Team
+			fmt.Fprintf(view, "   [white]%s[-]\n", team)
+		}
+		names := make([]string, 0, len(category.Leaders))
+		for _, leader := range category.Leaders {
+			names = append(names, fmt.Sprintf("%s [gray]%s[-]", leader.Name, leader.Value))
+		}
+		fmt.Fprintf(view, "     %-*s %s\n", width, category.Category, strings.Join(names, ", "))
+	}
+	fmt.Fprintf(view, "\n")
+}
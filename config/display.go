@@ -2,40 +2,116 @@ package config
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
+	"sync"
 	"time"
-	"strconv"
 
 	"github.com/mcbk51/scores_dash/api"
+	"github.com/mcbk51/scores_dash/grading"
 	"github.com/rivo/tview"
 )
 
-var leagueColors = map[string]string{
-	"NFL": "red",
-	"NBA": "blue",
-	"NHL": "orange",
-	"MLB": "green",
-}
+// How long a single refresh may take, including next game lookups
+const refreshTimeout = 25 * time.Second
 
-var leagueOrder = []string{"NFL", "NBA", "NHL", "MLB"}
+// Narrowest a league panel gets before the dashboard drops to fewer
+// columns, the most columns it spreads to, and the shortest a panel gets
+// before the grid has to scroll
+const (
+	minPanelWidth   = 80
+	maxPanelColumns = 4
+	minPanelHeight  = 4
+)
 
 type Display struct {
 	app      *tview.Application
-	view     *tview.TextView
-	scroller *Scroller
-	ctx      context.Context
-	quitChan chan bool
+	header   *tview.TextView
+	grid     *tview.Grid
+	scroller  *Scroller
+	provider  api.ScoreProvider
+	favorites *Favorites
+	ctx       context.Context
+	quitChan  chan bool
+
+	mu            sync.Mutex
+	cancelRefresh context.CancelFunc
+	lastGood      map[string]leagueSnapshot
+
+	// Favorites only filter, and the last error saving favorites
+	favoritesOnly bool
+	favoritesErr  error
+
+	// Last scoreboard built and the grid width it was laid out for
+	headerText string
+	boards     []leagueBoard
+	boardWidth int
+	panels     map[string]*tview.TextView
+
+	// Panels per grid row, only used from the draw goroutine
+	columns int
+
+	// Event under the cursor in each league's panel
+	selected map[string]string
+
+	// Games from the previous refresh by event, and the ones that changed
+	previous map[string]api.Game
+	changes  map[string]scoreChange
+}
+
+// Last games successfully fetched for a league, shown while it is failing
+type leagueSnapshot struct {
+	games   []api.Game
+	fetched time.Time
+}
+
+// One league's panel: its border title and the rows inside
+type leagueBoard struct {
+	league string
+	title  string
+	table  *scoreboard
 }
 
-func NewDisplay(app *tview.Application, view *tview.TextView, scroller *Scroller, ctx context.Context, quitChan chan bool) *Display {
-	return &Display{
+// Scoreboard rows along with the games they show, in order, so a row can
+// be selected
+type scoreboard struct {
+	*table
+	games     []api.Game
+	favorites *Favorites
+}
+
+// Adds a game's row, starring favorite teams
+func (s *scoreboard) addGame(game api.Game, cells ...string) {
+	if s.favorites.IsFavorite(game.League, game.AwayTeamID, game.AwayTeam) {
+		cells[colAway] = formatFavorite(cells[colAway])
+	}
+	if s.favorites.IsFavorite(game.League, game.HomeTeamID, game.HomeTeam) {
+		cells[colHome] = formatFavorite(cells[colHome])
+	}
+	s.addRegionRow(game.EventID, cells...)
+	s.games = append(s.games, game)
+}
+
+func NewDisplay(app *tview.Application, header *tview.TextView, grid *tview.Grid, scroller *Scroller, provider api.ScoreProvider, favorites *Favorites, ctx context.Context, quitChan chan bool) *Display {
+	d := &Display{
 		app: app,
-		view: view,
+		header: header,
+		grid: grid,
 		scroller: scroller,
+		provider: provider,
+		favorites: favorites,
 		ctx: ctx,
 		quitChan: quitChan,
+		lastGood: make(map[string]leagueSnapshot),
+		panels: make(map[string]*tview.TextView),
+		selected: make(map[string]string),
+		changes: make(map[string]scoreChange),
 	}
+	scroller.SetFocusChangedFunc(d.scrollToPanel)
+	return d
 }
 
 func (d *Display) cancelled() bool {
@@ -49,103 +125,463 @@ func (d *Display) cancelled() bool {
 	}
 }
 
+// Starts a new refresh, aborting any one still in flight
+func (d *Display) beginRefresh() (context.Context, context.CancelFunc) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.cancelRefresh != nil {
+		d.cancelRefresh()
+	}
+	ctx, cancel := context.WithTimeout(d.ctx, refreshTimeout)
+	d.cancelRefresh = cancel
+	return ctx, cancel
+}
+
 func (d *Display) MainOutput() {
 	if d.cancelled() {
 		return
 	}
 
-	games, err := api.GetGames("all", time.Now())
-	if d.cancelled() {
+	ctx, cancel := d.beginRefresh()
+	defer cancel()
+
+	result, err := d.provider.GetGames(ctx, "all", clock())
+	if d.cancelled() || errors.Is(ctx.Err(), context.Canceled) {
 		return
 	}
-	if err != nil {
-		d.view.Clear()
-		fmt.Fprintf(d.view, "[red]Error fetching scores: %v[-]\n", err)
-		d.app.Draw()
+
+	games, staleSince := d.withLastGood(result, err)
+	if err != nil && len(staleSince) == 0 {
+		d.mu.Lock()
+		d.headerText = fmt.Sprintf("[red]Error fetching scores: %v[-]", err)
+		d.boards = nil
+		d.mu.Unlock()
+		d.layout()
 		return
 	}
 
+	d.trackChanges(games)
 	activeByLeague, allByLeague := groupGamesByLeague(games)
 	sortedLeagues := sortLeaguesByActivity(allByLeague)
 
-	d.view.Clear()
-	fmt.Fprintf(d.view, "[yellow]=== Scores Dash ===[-] [grey]Updated: %s| %s[-]\n", time.Now().Format("3:04 PM"), d.scroller.FormatStatus())
+	badges := formatErrorBadges(result.Failed())
+	if err != nil {
+		badges += " [red]✗ refresh failed[-]"
+	}
+
+	d.mu.Lock()
+	favoritesOnly := d.favoritesOnly
+	if d.favoritesErr != nil {
+		badges += " [red]✗ favorites not saved[-]"
+	}
+	d.mu.Unlock()
+	if favoritesOnly {
+		badges += " [yellow]★ favorites only[-]"
+	}
 
+	var boards []leagueBoard
+	var idle []string
 	for _, league := range sortedLeagues {
 		activeGames := activeByLeague[league]
 		allGames := allByLeague[league]
 
 		finishedGames := getFinishedGamesToday(allGames)
-		color := leagueColors[league]
+		if favoritesOnly {
+			activeGames = favoriteGames(activeGames, d.favorites)
+			finishedGames = favoriteGames(finishedGames, d.favorites)
+		}
+		pinFavorites(finishedGames, d.favorites)
+		color := leagueColor(league)
+		stale := formatStale(staleSince[league])
+		board := newScoreboard(d.favorites)
 
 		// No Active Games
+		title := fmt.Sprintf(" [%s]%s[-] [gray]No games currently[-]%s ", color, league, stale)
 		if len(activeGames) == 0 {
-			d.renderNoLiveGames(league, color, finishedGames)
+			renderNoLiveGames(ctx, board, d.provider, d.favorites, favoritesOnly, league, finishedGames)
+		} else {
+			sortGamesByStatus(activeGames)
+			pinFavorites(activeGames, d.favorites)
+			title = formatLeagueTitle(league, color, stale, activeGames)
+			renderLiveGames(board, activeGames)
+			renderFinishedGames(board, finishedGames)
+		}
+
+		if len(board.rows) == 0 {
+			idle = append(idle, league)
 			continue
 		}
-		sortGamesByStatus(activeGames)
-		d.renderLiveGames(league, color, activeGames)
-		d.renderFinishedGames(finishedGames)
-		fmt.Fprintf(d.view, "\n")
+		boards = append(boards, leagueBoard{league: league, title: title, table: board})
+	}
+
+	headerText := fmt.Sprintf("[yellow]=== Scores Dash ===[-] [grey]Updated: %s| %s[-]%s", clock().Format("3:04 PM"), d.scroller.FormatStatus(), badges)
+	if len(idle) > 0 {
+		headerText += fmt.Sprintf("\n[gray]No games: %s[-]", strings.Join(idle, ", "))
+	}
+
+	d.mu.Lock()
+	d.headerText = headerText
+	d.boards = boards
+	d.mu.Unlock()
+	d.layout()
+}
+
+// Lays the league panels out in one to four columns depending on the
+// width of the grid, and fills each one from the last scoreboard built
+func (d *Display) layout() {
+	d.mu.Lock()
+	headerText := d.headerText
+	boards := d.boards
+	d.mu.Unlock()
+	changes := d.activeChanges()
+
+	d.app.QueueUpdateDraw(func() {
+		_, _, width, _ := d.grid.GetInnerRect()
+		columns := panelColumns(width, len(boards))
+		rows := (len(boards) + columns - 1) / columns
+
+		d.header.Clear()
+		fmt.Fprint(d.header, headerText)
+
+		// Taller panels get a bigger share of the height
+		heights := make([]int, rows)
+		for i, board := range boards {
+			heights[i/columns] = min(heights[i/columns], -(len(board.table.rows) + 2))
+		}
+
+		d.grid.Clear()
+		d.grid.SetColumns(make([]int, columns)...)
+		d.grid.SetRows(heights...)
+		d.grid.SetMinSize(minPanelHeight, 0)
+		d.columns = columns
+
+		views := make([]*tview.TextView, 0, len(boards))
+		for i, board := range boards {
+			panel := d.panel(board.league)
+			panel.SetTitle(board.title)
+			panel.Clear()
+			// Inside the border
+			board.table.withChanges(changes).render(panel, width/columns-2)
+			panel.Highlight(d.selection(board))
+			d.grid.AddItem(panel, i/columns, i%columns, 1, 1, 0, 0, false)
+			views = append(views, panel)
+		}
+		d.scroller.SetViews(views)
+
+		d.mu.Lock()
+		d.boardWidth = width
+		d.mu.Unlock()
+	})
+}
+
+// Scrolls the grid so the panel at index starts its first visible row, for
+// when the panels are taller than the screen
+func (d *Display) scrollToPanel(index int) {
+	if d.columns > 0 {
+		d.grid.SetOffset(index/d.columns, 0)
+	}
+}
+
+// Panels are kept per league so their scroll position survives a refresh
+func (d *Display) panel(league string) *tview.TextView {
+	if panel, ok := d.panels[league]; ok {
+		return panel
+	}
+	panel := tview.NewTextView().
+		SetDynamicColors(true).
+		SetRegions(true).
+		SetScrollable(true).
+		SetWrap(false)
+	panel.SetBorder(true).SetTitleAlign(tview.AlignLeft)
+	d.panels[league] = panel
+	return panel
+}
+
+// Event selected in a board, dropping the selection once its game is gone
+func (d *Display) selection(board leagueBoard) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	eventID := d.selected[board.league]
+	for _, game := range board.table.games {
+		if game.EventID == eventID {
+			return eventID
+		}
 	}
+	delete(d.selected, board.league)
+	return ""
 }
 
-func (d *Display) renderNoLiveGames(league, color string, finishedGames []api.Game){
-	fmt.Fprintf(d.view, "[%s]▼ %s[-][gray] No games currently[-]\n", color, league)
+// Board shown in the focused panel, if any
+func (d *Display) focusedBoard() (leagueBoard, *tview.TextView, bool) {
+	focused := d.scroller.Focused()
+	d.mu.Lock()
+	defer d.mu.Unlock()
 
-	nextGameTime, awayTeam, homeTeam, dateStr, awayOdds, homeOdds := findNextGame(league)
-	if !nextGameTime.IsZero() {
-		localTime := nextGameTime.Local()
-		// Output for next game
-		fmt.Fprintf(d.view, "  [gray]Next game: %s%s @ %s%s - %s at %s[-]\n", awayTeam, awayOdds,  homeOdds, homeTeam, dateStr, localTime.Format("3:04 PM"))
+	for _, board := range d.boards {
+		if d.panels[board.league] == focused {
+			return board, focused, true
+		}
 	}
-	d.renderFinishedGames(finishedGames)
+	return leagueBoard{}, nil, false
 }
 
-func (d *Display) renderLiveGames(league,color string, games []api.Game) {
-	liveCount := countLiveGames(games)
+// Moves the cursor in the focused panel by delta games, starting on the
+// first game when nothing is selected yet
+func (d *Display) MoveSelection(delta int) {
+	board, panel, ok := d.focusedBoard()
+	if !ok || len(board.table.games) == 0 {
+		return
+	}
 
-	if liveCount > 0 {
-		fmt.Fprintf(d.view, "[%s]▼ %s[-] [green]● %d LIVE[-]\n", color, league, liveCount)
+	index := -1
+	if eventID := d.selection(board); eventID != "" {
+		for i, game := range board.table.games {
+			if game.EventID == eventID {
+				index = i + delta
+			}
+		}
 	}
+	index = max(0, min(index, len(board.table.games)-1))
 
-	for _, game := range games {
-		statusColor, statusText := formatGameStatus(game)
-		if statusColor == "" {
+	eventID := board.table.games[index].EventID
+	d.mu.Lock()
+	d.selected[board.league] = eventID
+	d.mu.Unlock()
+	panel.Highlight(eventID).ScrollToHighlight()
+}
+
+// Adds or removes the selected game's home or away team as a favorite
+func (d *Display) ToggleFavorite(home bool) {
+	game, ok := d.Selected()
+	if !ok {
+		return
+	}
+	teamID, team := game.AwayTeamID, game.AwayTeam
+	if home {
+		teamID, team = game.HomeTeamID, game.HomeTeam
+	}
+	err := d.favorites.Toggle(game.League, teamID, team)
+
+	d.mu.Lock()
+	d.favoritesErr = err
+	d.mu.Unlock()
+}
+
+func (d *Display) ToggleFavoritesOnly() {
+	d.mu.Lock()
+	d.favoritesOnly = !d.favoritesOnly
+	d.mu.Unlock()
+}
+
+// Game under the cursor in the focused panel
+func (d *Display) Selected() (api.Game, bool) {
+	board, _, ok := d.focusedBoard()
+	if !ok {
+		return api.Game{}, false
+	}
+	eventID := d.selection(board)
+	if eventID == "" {
+		return api.Game{}, false
+	}
+	for _, game := range board.table.games {
+		if game.EventID == eventID {
+			return game, true
+		}
+	}
+	return api.Game{}, false
+}
+
+// As many columns as fit at minPanelWidth, up to four and never more than
+// there are panels to fill them
+func panelColumns(width, panels int) int {
+	return max(1, min(width/minPanelWidth, maxPanelColumns, panels))
+}
+
+// Lays the dashboard out again if the grid changed width since the last
+// layout. Meant to be called after every screen draw.
+func (d *Display) CheckWidth() {
+	_, _, width, _ := d.grid.GetInnerRect()
+	d.mu.Lock()
+	changed := width != d.boardWidth
+	d.mu.Unlock()
+
+	if changed {
+		go d.layout()
+	}
+}
+
+// Games from the latest snapshot of every league, in league order, each
+// league followed by the next games its board shows while it is idle
+func (d *Display) Games() []api.Game {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	shown := make(map[string][]api.Game)
+	for _, board := range d.boards {
+		shown[board.league] = board.table.games
+	}
+
+	var games []api.Game
+	for _, league := range api.Leagues() {
+		snapshot := d.lastGood[league.Name].games
+		games = append(games, snapshot...)
+		for _, game := range shown[league.Name] {
+			if !slices.ContainsFunc(snapshot, func(g api.Game) bool { return g.EventID == game.EventID }) {
+				games = append(games, game)
+			}
+		}
+	}
+	return games
+}
+
+// Remembers every league that fetched cleanly and fills in failed ones from
+// their last good snapshot, returning when each filled in league was fetched
+func (d *Display) withLastGood(result api.GamesResult, err error) ([]api.Game, map[string]time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var games []api.Game
+	staleSince := make(map[string]time.Time)
+
+	if err != nil {
+		for league, snap := range d.lastGood {
+			games = append(games, snap.games...)
+			staleSince[league] = snap.fetched
+		}
+		return games, staleSince
+	}
+
+	for _, league := range result.Leagues {
+		if league.Err == nil {
+			d.lastGood[league.League] = leagueSnapshot{games: league.Games, fetched: clock()}
+			games = append(games, league.Games...)
 			continue
 		}
-		awayOdds := formatOdds(game.AwaySpread, game.AwayOdds)
-		homeOdds := formatOdds(game.HomeSpread, game.HomeOdds)
+		if snap, ok := d.lastGood[league.League]; ok {
+			games = append(games, snap.games...)
+			staleSince[league.League] = snap.fetched
+		}
+	}
+	return games, staleSince
+}
+
+// Scoreboard columns, in the order cells are added to a row
+const (
+	colAway = iota
+	colAwayOdds
+	colAwayScore
+	colAt
+	colHomeScore
+	colHomeOdds
+	colHome
+	colTotal
+	colDraw
+	colStatus
+	colBook
+)
+
+// Book attribution goes first on narrow screens, then totals and draw
+// prices, then spreads and moneylines; team names shrink after that
+func newScoreboard(favorites *Favorites) *scoreboard {
+	return &scoreboard{favorites: favorites, table: newTable(
+		tableColumn{align: tview.AlignLeft, shrink: true},
+		tableColumn{align: tview.AlignLeft, priority: 2},
+		tableColumn{align: tview.AlignRight},
+		tableColumn{align: tview.AlignCenter},
+		tableColumn{align: tview.AlignLeft},
+		tableColumn{align: tview.AlignLeft, priority: 2},
+		tableColumn{align: tview.AlignLeft, shrink: true},
+		tableColumn{align: tview.AlignLeft, priority: 3},
+		tableColumn{align: tview.AlignLeft, priority: 3},
+		tableColumn{align: tview.AlignLeft, shrink: true},
+		tableColumn{align: tview.AlignLeft, priority: 4},
+	)}
+}
+
+func formatLeagueTitle(league, color, stale string, games []api.Game) string {
+	if liveCount := countLiveGames(games); liveCount > 0 {
+		return fmt.Sprintf(" [%s]%s[-] [green]● %d LIVE[-]%s ", color, league, liveCount, stale)
+	}
+	return fmt.Sprintf(" [%s]%s[-]%s ", color, league, stale)
+}
 
-		awayInfo := fmt.Sprintf("%s (%s)", game.AwayTeam, game.AwayRecord)
-		if game.AwaySpread != "" {
-			awayInfo += fmt.Sprintf("[blue]%s[-]", awayOdds)
+// Favorites' next games first, then the league's next game unless it is
+// already shown or filtered out, then today's results
+func renderNoLiveGames(ctx context.Context, board *scoreboard, provider api.ScoreProvider, favorites *Favorites, favoritesOnly bool, league string, finishedGames []api.Game) {
+	shown := make(map[string]bool)
+	for _, game := range findFavoriteGames(ctx, provider, favorites, league) {
+		board.addGame(game, nextGameRow(game)...)
+		shown[game.EventID] = true
+	}
+	if game := findNextGame(ctx, provider, league); game != nil && !shown[game.EventID] {
+		if !favoritesOnly || favorites.HasGame(*game) {
+			board.addGame(*game, nextGameRow(*game)...)
 		}
+	}
+	renderFinishedGames(board, finishedGames)
+}
 
-		homeInfo := ""
-		if game.HomeSpread != "" {
-			homeInfo = fmt.Sprintf("[blue]%s[-] ", homeOdds)
+// Next game for an idle league, all in gray
+func nextGameRow(game api.Game) []string {
+	localTime := game.StartTime.Local()
+	// Injury markers and movement arrows change color, switch back to gray
+	row := make([]string, colBook+1)
+	row[colAway] = "[gray]" + game.AwayTeam + formatInjuries(game.AwayInjuries)
+	row[colAwayOdds] = "[gray]" + formatOdds(game.Odds.AwaySpread, game.Odds.AwayMoneyLine) + spreadMovement(game.LineHistory, false)
+	row[colAt] = "[gray]@[-]"
+	row[colHomeOdds] = "[gray]" + formatOdds(game.Odds.HomeSpread, game.Odds.HomeMoneyLine) + spreadMovement(game.LineHistory, true)
+	row[colHome] = "[gray]" + game.HomeTeam + formatInjuries(game.HomeInjuries)
+	row[colTotal] = "[gray]" + formatTotal(game.Odds.Total) + totalMovement(game.LineHistory)
+	row[colDraw] = formatDrawOdds(game)
+	row[colStatus] = fmt.Sprintf("[gray]Next: %s at %s[-]", formatGameDate(game.StartTime), localTime.Format("3:04 PM"))
+	row[colBook] = formatOddsSource(game.Odds.Provider)
+	return row
+}
+
+func renderLiveGames(board *scoreboard, games []api.Game) {
+	for _, game := range games {
+		statusColor, statusText := formatGameStatus(game)
+		if statusColor == "" {
+			continue
 		}
-		homeInfo += fmt.Sprintf("%s (%s)", game.HomeTeam, game.HomeRecord)
+		board.addGame(game, liveGameRow(game, statusColor, statusText)...)
+	}
+}
 
-		fmt.Fprintf(d.view, " [-][blue]%s [white]%s [-][purple]%d  [white]@  [purple]%d [-]%s  [%s]{%s}[-]\n",
-			game.OverUnder,
-			awayInfo,
-			game.AwayScore,
-			game.HomeScore,
-			homeInfo,
-			statusColor,
-			statusText)
+func liveGameRow(game api.Game, statusColor, statusText string) []string {
+	row := make([]string, colBook+1)
+	row[colAway] = fmt.Sprintf("[white]%s (%s)%s", game.AwayTeam, game.AwayRecord, formatInjuries(game.AwayInjuries))
+	row[colHome] = fmt.Sprintf("[white]%s (%s)%s", game.HomeTeam, game.HomeRecord, formatInjuries(game.HomeInjuries))
+
+	covering := grading.Covering(game)
+	if game.Odds.HasSpread() {
+		awayOdds := formatOdds(game.Odds.AwaySpread, game.Odds.AwayMoneyLine)
+		homeOdds := formatOdds(game.Odds.HomeSpread, game.Odds.HomeMoneyLine)
+		row[colAwayOdds] = fmt.Sprintf("[blue]%s[-]%s%s", awayOdds, spreadMovement(game.LineHistory, false), formatCovering(covering.AwaySpread))
+		row[colHomeOdds] = fmt.Sprintf("%s[blue]%s[-]%s", formatCovering(covering.HomeSpread), homeOdds, spreadMovement(game.LineHistory, true))
 	}
+
+	row[colAwayScore] = fmt.Sprintf("[purple]%d[-]", game.AwayScore)
+	row[colAt] = "@"
+	row[colHomeScore] = fmt.Sprintf("[purple]%d[-]", game.HomeScore)
+	if game.Odds.HasTotal() {
+		row[colTotal] = fmt.Sprintf("[blue]%s[-]%s", formatTotal(game.Odds.Total), totalMovement(game.LineHistory))
+	}
+	row[colDraw] = formatDrawOdds(game)
+	row[colStatus] = fmt.Sprintf("[%s]{%s}[-]", statusColor, statusText)
+	row[colBook] = formatOddsSource(game.Odds.Provider)
+	return row
 }
 
-func (d *Display) renderFinishedGames(games []api.Game) {
+func renderFinishedGames(board *scoreboard, games []api.Game) {
 	if len(games) == 0 {
 		return
 	}
-	fmt.Fprintf(d.view, "[orange]── Finished Games Results ──[-]\n")
+	board.addLine("[orange]── Finished Games Results ──[-]")
 	for _, game := range games {
-		printFinishedGames(d.view, game)
+		board.addGame(game, finishedGameRow(game)...)
 	}
 }
 
@@ -167,26 +603,49 @@ func (d *Display) StartTicker(interval time.Duration) {
 }
 
 // helper functions
+func formatStale(since time.Time) string {
+	if since.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf(" [red](stale since %s)[-]", since.Format("3:04 PM"))
+}
+
+func formatErrorBadges(failed []api.LeagueResult) string {
+	badges := ""
+	for _, league := range failed {
+		badges += fmt.Sprintf(" [red]✗ %s[-]", league.League)
+	}
+	return badges
+}
+
 func groupGamesByLeague(games []api.Game) (map[string][]api.Game, map[string][]api.Game) {
 	active := make(map[string][]api.Game)
 	all := make(map[string][]api.Game)
 	for _, game := range games {
 		all[game.League] = append(all[game.League], game)
-		if isLive(game.Status) || isUpcoming(game.StartTime, 30*time.Minute) {
+		if game.State.IsLive() || awaitingStart(game) || isUpcoming(game.StartTime, 30*time.Minute) {
 			active[game.League] = append(active[game.League], game)
 		}
 	}
 	return active, all
 }
 
+func leagueColor(name string) string {
+	if league, ok := api.LookupLeague(name); ok {
+		return league.Color
+	}
+	return "white"
+}
+
 func sortLeaguesByActivity(allByLeague map[string][]api.Game) []string {
-	withGames := make([]string, 0, len(leagueOrder))
-	withoutGames := make([]string, 0, len(leagueOrder))
-	for _, league := range leagueOrder {
-		if len(allByLeague[league]) > 0 {
-			withGames = append(withGames, league)
+	leagues := api.Leagues()
+	withGames := make([]string, 0, len(leagues))
+	withoutGames := make([]string, 0, len(leagues))
+	for _, league := range leagues {
+		if len(allByLeague[league.Name]) > 0 {
+			withGames = append(withGames, league.Name)
 		} else {
-			withoutGames = append(withoutGames, league)
+			withoutGames = append(withoutGames, league.Name)
 		}
 	}
 	return append(withGames, withoutGames...)
@@ -194,8 +653,8 @@ func sortLeaguesByActivity(allByLeague map[string][]api.Game) []string {
 
 func sortGamesByStatus(games []api.Game) []api.Game {
 	sort.Slice(games, func(i, j int) bool {
-		liveI := isLive(games[i].Status)
-		liveJ := isLive(games[j].Status)
+		liveI := games[i].State.IsLive()
+		liveJ := games[j].State.IsLive()
 		if liveI != liveJ {
 			return liveI
 		}
@@ -206,16 +665,31 @@ func sortGamesByStatus(games []api.Game) []api.Game {
 
 func formatGameStatus(game api.Game) (color, text string) {
 	switch {
-	case isLive(game.Status):
+	case game.State == api.InProgress:
 		text = "LIVE"
 		if game.Clock != "" && game.Period != "" {
 			text = fmt.Sprintf("%s - %s", game.Clock, game.Period)
 		}
 		return "green", text
 
+	case game.State.IsLive():
+		// Breaks and delays, ESPN's detail says which one
+		text = game.StatusDetail
+		if text == "" {
+			text = game.State.String()
+		}
+		return "yellow", text
+
+	case awaitingStart(game):
+		text = game.StatusDetail
+		if text == "" {
+			text = "Awaiting start"
+		}
+		return "yellow", text
+
 	case isUpcoming(game.StartTime, 45*time.Minute):
 		localTime := game.StartTime.Local()
-		minutesUntil := int(time.Until(game.StartTime).Minutes())
+		minutesUntil := int(game.StartTime.Sub(clock()).Minutes())
 		text = fmt.Sprintf("Starts in %dm (%s)", minutesUntil, localTime.Format("3:04 PM"))
 		return "yellow", text
 	default:
@@ -224,68 +698,52 @@ func formatGameStatus(game api.Game) (color, text string) {
 }
 
 
-func spreadResult(spread string, scoreDiff int, teamWon bool) string {
-	if spread == "" || !teamWon {
-		return ""
-	}
-
-	spreadValue, err := strconv.ParseFloat(spread, 64)
-	if err != nil {
-		return ""
-	}
-
-	if spreadValue > 0 {
-		return "[green]✓[-]"
-	}
+// Stars a favorite team's cell
+func formatFavorite(cell string) string {
+	return "[yellow]★[-] " + cell
+}
 
-	scoreDiffFloat := float64(scoreDiff)
-	needed := -spreadValue
-	switch {
-	case scoreDiffFloat > needed:
+// Check, cross or P for a settled bet, empty when there is nothing to grade
+func formatGrade(result grading.Result) string {
+	switch result {
+	case grading.Win:
 		return "[green]✓[-]"
-	case scoreDiffFloat == needed:
-		return "[yellow]P[-]"
-	default:
+	case grading.Loss:
 		return "[red]✗[-]"
+	case grading.Push:
+		return "[yellow]P[-]"
 	}
+	return ""
 }
 
-
-func checkSpreadIfHomeWin(game api.Game) string {
-	return spreadResult(game.HomeSpread, game.HomeScore - game.AwayScore, game.HomeScore > game.AwayScore)
-}
-
-
-func checkSpreadIfAwayWin(game api.Game) string {
-	return spreadResult(game.AwaySpread, game.AwayScore - game.HomeScore, game.AwayScore > game.HomeScore)
-}
-
-func checkOverUnderResult(game api.Game) string {
-	if game.OverUnder == "" {
-		return ""
-	}
-
-	// Parse the over/under value from the format "O/U 45.5"
-	var ouValue float64
-	_, err := fmt.Sscanf(game.OverUnder, "O/U %f", &ouValue)
-	if err != nil {
-		return ""
-	}
-
-	totalScore := float64(game.HomeScore + game.AwayScore)
-
-	if totalScore > ouValue {
+// Which way the total went, from the over's grade
+func formatTotalResult(over grading.Result) string {
+	switch over {
+	case grading.Win:
 		return "[green]↑[-]"
-	} else if totalScore < ouValue {
+	case grading.Loss:
 		return "[green]↓[-]"
+	case grading.Push:
+		return "[yellow]P[-]"
 	}
+	return ""
+}
 
-	// Push (exact match)
-	return "[yellow]P[-]"
+// Light next to a live spread showing whether that side is covering
+func formatCovering(result grading.Result) string {
+	switch result {
+	case grading.Win:
+		return "[green]●[-]"
+	case grading.Loss:
+		return "[red]●[-]"
+	case grading.Push:
+		return "[yellow]●[-]"
+	}
+	return ""
 }
 
 
-func printFinishedGames(scoreview *tview.TextView, game api.Game) {
+func finishedGameRow(game api.Game) []string {
 	awayStyle, homeStyle := "white", "white"
 	switch {
 	case game.AwayScore > game.HomeScore:
@@ -294,26 +752,29 @@ func printFinishedGames(scoreview *tview.TextView, game api.Game) {
 		awayStyle, homeStyle = "gray", "green"
 	}
 
-	awayOdds := formatOdds(game.AwaySpread, game.AwayOdds)
-	awaySpreadResult := checkSpreadIfAwayWin(game)
-	homeOdds := formatOdds(game.HomeSpread, game.HomeOdds)
-	homeSpreadResult := checkSpreadIfHomeWin(game)
-
-	if game.AwaySpread != "" {
-		awayOdds = fmt.Sprintf("%s", awayOdds)
+	grades := grading.Grade(game)
+	row := make([]string, colBook+1)
+	row[colAway] = fmt.Sprintf("[%s]%s (%s)[-]", awayStyle, game.AwayTeam, game.AwayRecord)
+	row[colAwayOdds] = fmt.Sprintf("%s [%s]%s[-]", formatGrade(grades.AwaySpread), awayStyle, formatOdds(game.Odds.AwaySpread, game.Odds.AwayMoneyLine))
+	row[colAwayScore] = fmt.Sprintf("[%s]%d[-]", awayStyle, game.AwayScore)
+	row[colAt] = "@"
+	row[colHomeScore] = fmt.Sprintf("[%s]%d[-]", homeStyle, game.HomeScore)
+	row[colHomeOdds] = fmt.Sprintf("[%s]%s[-] %s", homeStyle, formatOdds(game.Odds.HomeSpread, game.Odds.HomeMoneyLine), formatGrade(grades.HomeSpread))
+	row[colHome] = fmt.Sprintf("[%s]%s (%s)[-]", homeStyle, game.HomeTeam, game.HomeRecord)
+	if game.Odds.HasTotal() {
+		row[colTotal] = fmt.Sprintf("[blue]%s %s[-]", formatTotal(game.Odds.Total), formatTotalResult(grades.Over))
 	}
+	row[colDraw] = formatDrawOdds(game)
 
-	if game.HomeSpread != "" {
-		homeOdds = fmt.Sprintf("%s", homeOdds)
+	// Overtime finals, postponements and the like
+	statusInfo := ""
+	if game.StatusDetail != "" && game.StatusDetail != "Final" {
+		statusInfo = fmt.Sprintf("[gray]%s[-]", game.StatusDetail)
 	}
-	
-	oddsInfo := ""
-	overUnderResult := checkOverUnderResult(game)
-	if game.OverUnder != "" {
-		oddsInfo = fmt.Sprintf(" [blue]%s %s[-]", game.OverUnder, overUnderResult)
+	if game.HomeShootout != 0 || game.AwayShootout != 0 {
+		statusInfo += fmt.Sprintf(" [gray](%d-%d pens)[-]", game.AwayShootout, game.HomeShootout)
 	}
-
-	fmt.Fprintf(scoreview, "  [%s]%s(%s) %s [%s]%s %d[-]  @ [%s]%d %s %s [%s]%s(%s) [-]%s\n", 
-		awayStyle, game.AwayTeam, game.AwayRecord,  awaySpreadResult, awayStyle, awayOdds, game.AwayScore, 
-		homeStyle, game.HomeScore, homeOdds, homeSpreadResult, homeStyle, game.HomeTeam, game.HomeRecord, oddsInfo)
+	row[colStatus] = statusInfo
+	row[colBook] = formatOddsSource(game.Odds.Provider)
+	return row
 }
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mcbk51/scores_dash/api"
+)
+
+func TestFormatGameStatus(t *testing.T) {
+	now := time.Date(2026, 10, 16, 19, 0, 0, 0, time.UTC)
+	defer SetClock(time.Now)
+	SetClock(func() time.Time { return now })
+
+	tests := []struct {
+		name       string
+		game       api.Game
+		wantActive bool
+		want       string
+	}{
+		{
+			name:       "in progress",
+			game:       api.Game{State: api.InProgress, Clock: "7:42", Period: "3rd Qtr", StartTime: now.Add(-time.Hour)},
+			wantActive: true,
+			want:       "7:42 - 3rd Qtr",
+		},
+		{
+			name:       "delayed before the start",
+			game:       api.Game{State: api.Delayed, StatusDetail: "Rain Delay", StartTime: now.Add(-10 * time.Minute)},
+			wantActive: true,
+			want:       "Rain Delay",
+		},
+		{
+			name:       "scheduled past its start",
+			game:       api.Game{State: api.Scheduled, StartTime: now.Add(-5 * time.Minute)},
+			wantActive: true,
+			want:       "Awaiting start",
+		},
+		{
+			name:       "starting soon",
+			game:       api.Game{State: api.Scheduled, StartTime: now.Add(20 * time.Minute)},
+			wantActive: true,
+			want:       "Starts in 20m",
+		},
+		{
+			name: "later today",
+			game: api.Game{State: api.Scheduled, StartTime: now.Add(3 * time.Hour)},
+		},
+		{
+			name: "final",
+			game: api.Game{State: api.Final, StartTime: now.Add(-3 * time.Hour)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.game.League = "MLB"
+			active, _ := groupGamesByLeague([]api.Game{tt.game})
+			if got := len(active["MLB"]) == 1; got != tt.wantActive {
+				t.Errorf("active = %v, want %v", got, tt.wantActive)
+			}
+
+			_, text := formatGameStatus(tt.game)
+			if !strings.HasPrefix(text, tt.want) {
+				t.Errorf("formatGameStatus = %q, want it to start with %q", text, tt.want)
+			}
+			if tt.want == "" && text != "" {
+				t.Errorf("formatGameStatus = %q, want none", text)
+			}
+		})
+	}
+}
+
+func TestPanelColumns(t *testing.T) {
+	tests := []struct {
+		width, panels, want int
+	}{
+		{80, 6, 1},
+		{159, 6, 1},
+		{160, 6, 2},
+		{250, 6, 3},
+		{320, 6, 4},
+		{500, 6, 4},
+		{500, 3, 3},
+		{300, 1, 1},
+		{40, 6, 1},
+		{300, 0, 1},
+	}
+	for _, tt := range tests {
+		if got := panelColumns(tt.width, tt.panels); got != tt.want {
+			t.Errorf("panelColumns(%d, %d) = %d, want %d", tt.width, tt.panels, got, tt.want)
+		}
+	}
+}
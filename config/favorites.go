@@ -0,0 +1,161 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/mcbk51/scores_dash/api"
+)
+
+// A followed team. Entries written by hand may leave out the ID, the name is
+// then matched against the full team name or its last words, e.g. "Chiefs".
+type FavoriteTeam struct {
+	League string `json:"league"`
+	Team   string `json:"team"`
+	TeamID string `json:"team_id,omitempty"`
+}
+
+// Favorite teams, saved to a JSON file whenever they change
+type Favorites struct {
+	mu    sync.Mutex
+	path  string
+	teams []FavoriteTeam
+}
+
+// Default favorites file under the user's config directory, empty if there
+// is none
+func DefaultFavoritesPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "scores_dash", "favorites.json")
+}
+
+// Reads favorites from path. A missing file means no favorites yet; an
+// empty path keeps them in memory only.
+func LoadFavorites(path string) (*Favorites, error) {
+	favorites := &Favorites{path: path}
+	if path == "" {
+		return favorites, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return favorites, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read favorites: %w", err)
+	}
+	if err := json.Unmarshal(data, &favorites.teams); err != nil {
+		return nil, fmt.Errorf("failed to parse favorites %s: %w", path, err)
+	}
+	return favorites, nil
+}
+
+func (f *Favorites) save() error {
+	if f.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(f.teams, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create favorites dir: %w", err)
+	}
+	if err := os.WriteFile(f.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to save favorites: %w", err)
+	}
+	return nil
+}
+
+// Index of the favorite matching a team, -1 if it isn't one. Entries
+// matched by name pick up the team's ID so its schedule can be looked up.
+func (f *Favorites) find(league, teamID, team string) int {
+	for i, favorite := range f.teams {
+		if !strings.EqualFold(favorite.League, league) {
+			continue
+		}
+		if favorite.TeamID != "" {
+			if favorite.TeamID == teamID {
+				return i
+			}
+			continue
+		}
+		name := strings.ToLower(favorite.Team)
+		full := strings.ToLower(team)
+		if name != "" && (full == name || strings.HasSuffix(full, " "+name)) {
+			f.teams[i].TeamID = teamID
+			return i
+		}
+	}
+	return -1
+}
+
+func (f *Favorites) IsFavorite(league, teamID, team string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.find(league, teamID, team) >= 0
+}
+
+// Whether either side of the game is a favorite
+func (f *Favorites) HasGame(game api.Game) bool {
+	return f.IsFavorite(game.League, game.AwayTeamID, game.AwayTeam) ||
+		f.IsFavorite(game.League, game.HomeTeamID, game.HomeTeam)
+}
+
+// Adds the team, or removes it if it is already a favorite, and saves
+func (f *Favorites) Toggle(league, teamID, team string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if i := f.find(league, teamID, team); i >= 0 {
+		f.teams = append(f.teams[:i], f.teams[i+1:]...)
+	} else {
+		f.teams = append(f.teams, FavoriteTeam{League: league, Team: team, TeamID: teamID})
+	}
+	return f.save()
+}
+
+// Whether any favorite in the league is still known only by name
+func (f *Favorites) missingIDs(league string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, favorite := range f.teams {
+		if strings.EqualFold(favorite.League, league) && favorite.TeamID == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Fills in the IDs of favorites known only by name from the league's teams
+func (f *Favorites) resolveIDs(league string, teams []api.Team) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, team := range teams {
+		f.find(league, team.ID, team.Name)
+	}
+}
+
+// Favorites in a league whose ID is known
+func (f *Favorites) InLeague(league string) []FavoriteTeam {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var teams []FavoriteTeam
+	for _, favorite := range f.teams {
+		if strings.EqualFold(favorite.League, league) && favorite.TeamID != "" {
+			teams = append(teams, favorite)
+		}
+	}
+	return teams
+}
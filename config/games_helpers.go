@@ -1,59 +1,178 @@
 package config
 
 import (
+	"context"
 	"fmt"
 	"sort"	
 	"time"
 	"github.com/mcbk51/scores_dash/api"
+	"github.com/mcbk51/scores_dash/grading"
 )
 
+// Source of the current time, swapped out when replaying a recording
+var clock = time.Now
 
-func findNextGame(league string) (time.Time, string, string, string, string, string) {
-	games, err := api.GetGames(league, time.Now())
-	if err == nil && len(games) > 0 {
-		now := time.Now()
-		for _, game := range games {
-			if game.StartTime.After(now) {
-				awayOdds := formatOdds(game.AwaySpread, game.AwayOdds)
-				homeOdds := formatOdds(game.HomeSpread, game.HomeOdds)
-				dateStr  := formatGameDate(game.StartTime)
-				return game.StartTime, game.AwayTeam, game.HomeTeam,  dateStr, awayOdds, homeOdds
-			}
+func SetClock(now func() time.Time) {
+	clock = now
+}
+
+
+func findNextGame(ctx context.Context, provider api.ScoreProvider, league string) *api.Game {
+	game, err := provider.NextGame(ctx, league, clock())
+	if err != nil {
+		return nil
+	}
+	return game
+}
+
+// Next game for each favorite in the league, soonest first. Needs a provider
+// that can look up team schedules; favorites known only by name are looked
+// up in the league's team list first when the provider has one.
+func findFavoriteGames(ctx context.Context, provider api.ScoreProvider, favorites *Favorites, league string) []api.Game {
+	schedules, ok := provider.(api.ScheduleProvider)
+	if !ok {
+		return nil
+	}
+	if lister, ok := provider.(api.TeamsProvider); ok && favorites.missingIDs(league) {
+		if teams, err := lister.GetTeams(ctx, league); err == nil {
+			favorites.resolveIDs(league, teams)
 		}
 	}
 
-	for i := 1 ; i < 7 ; i++ {
-		futureDate := time.Now().AddDate(0, 0, i)
-		games, err := api.GetGames(league, futureDate)
+	var games []api.Game
+	seen := make(map[string]bool)
+	for _, team := range favorites.InLeague(league) {
+		schedule, err := schedules.GetTeamSchedule(ctx, league, team.TeamID)
 		if err != nil {
 			continue
 		}
-		if len(games) > 0 {
-			sort.Slice(games, func(i, j int) bool {
-				return games[i].StartTime.Before(games[j].StartTime)
-			})
-			dateStr  := formatGameDate(games[0].StartTime)
-			awayOdds := formatOdds(games[0].AwaySpread, games[0].AwayOdds)
-			homeOdds := formatOdds(games[0].HomeSpread, games[0].HomeOdds)
-			return games[0].StartTime,games[0].AwayTeam, games[0].HomeTeam,  dateStr, awayOdds, homeOdds
+		for _, game := range schedule.Next(1) {
+			if !seen[game.EventID] {
+				seen[game.EventID] = true
+				games = append(games, game)
+			}
 		}
 	}
-	return time.Time{}, "", "", "", "", ""
+
+	sort.Slice(games, func(i, j int) bool {
+		return games[i].StartTime.Before(games[j].StartTime)
+	})
+	return games
+}
+
+// Only the games a favorite plays in
+func favoriteGames(games []api.Game, favorites *Favorites) []api.Game {
+	var kept []api.Game
+	for _, game := range games {
+		if favorites.HasGame(game) {
+			kept = append(kept, game)
+		}
+	}
+	return kept
+}
+
+// Moves favorites' games to the top, keeping the order otherwise
+func pinFavorites(games []api.Game, favorites *Favorites) {
+	sort.SliceStable(games, func(i, j int) bool {
+		return favorites.HasGame(games[i]) && !favorites.HasGame(games[j])
+	})
+}
+
+func formatOdds(spread float64, moneyline int) string {
+	if spread != 0 && moneyline != 0 {
+		return fmt.Sprintf("[%s | %s]", formatSpread(spread), formatMoneyLine(moneyline))
+	} else if spread != 0 {
+		return fmt.Sprintf("[%s]", formatSpread(spread))
+	} else if moneyline != 0 {
+		return fmt.Sprintf("[%s]", formatMoneyLine(moneyline))
+	}
+	return ""
+}
+
+func formatSpread(spread float64) string {
+	return fmt.Sprintf("%+.1f", spread)
+}
+
+// American odds always carry their sign, e.g. "+150" or "-110"
+func formatMoneyLine(price int) string {
+	if price == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%+d", price)
+}
+
+func formatTotal(total float64) string {
+	if total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("O/U %.1f", total)
+}
+
+// Arrow showing which way a side's spread moved since the previous line,
+// up when the side is getting more points
+func spreadMovement(history api.LineHistory, home bool) string {
+	previous, ok := history.Previous()
+	if !ok {
+		return ""
+	}
+	current, _ := history.Current()
+	if home {
+		return movementArrow(previous.HomeSpread, current.HomeSpread)
+	}
+	return movementArrow(previous.AwaySpread, current.AwaySpread)
+}
+
+func totalMovement(history api.LineHistory) string {
+	previous, ok := history.Previous()
+	if !ok {
+		return ""
+	}
+	current, _ := history.Current()
+	return movementArrow(previous.Total, current.Total)
 }
 
-func formatOdds(spread string, moneyline string) string {
-	if spread != "" && moneyline != "" {
-		return fmt.Sprintf("[%s | %s]", spread, moneyline)
-	} else if spread != "" {
-		return fmt.Sprintf("[%s]", spread)
-	} else if moneyline != "" {
-		return fmt.Sprintf("[%s]", moneyline)
+func movementArrow(previous, current float64) string {
+	switch {
+	case previous == 0 || current == 0:
+		return ""
+	case current > previous:
+		return "[yellow]↗[-]"
+	case current < previous:
+		return "[yellow]↘[-]"
 	}
 	return ""
 }
 
+// Names the book the line came from, e.g. "via DraftKings"
+func formatOddsSource(provider string) string {
+	if provider == "" {
+		return ""
+	}
+	return fmt.Sprintf("[gray]via %s[-]", provider)
+}
+
+// Compact marker for a team's key injuries, e.g. "✚2"
+func formatInjuries(injuries []api.Injury) string {
+	if len(injuries) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" [red]✚%d[-]", len(injuries))
+}
+
+// Draw price for three-way markets, marked once a finished game ends level
+func formatDrawOdds(game api.Game) string {
+	if game.Odds.DrawMoneyLine == 0 {
+		return ""
+	}
+	result := formatGrade(grading.Grade(game).DrawMoneyLine)
+	if result != "" {
+		result = " " + result
+	}
+	return fmt.Sprintf("[blue]Draw [%s][-]%s", formatMoneyLine(game.Odds.DrawMoneyLine), result)
+}
+
 func formatGameDate(t time.Time) string {
-	now := time.Now()
+	now := clock()
 	gameDate := t.Local()
 	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	game := time.Date(gameDate.Year(), gameDate.Month(), gameDate.Day(), 0, 0, 0, 0, gameDate.Location())
@@ -74,7 +193,7 @@ func allGameFinishedforToday(games []api.Game) bool {
 		return false
 	}
 
-	now := time.Now()
+	now := clock()
 	todayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	todayEnd := todayStart.AddDate(0, 0, 1)
 	hasGamesToday := false
@@ -83,7 +202,7 @@ func allGameFinishedforToday(games []api.Game) bool {
 		if game.StartTime.After(todayStart) && game.StartTime.Before(todayEnd) {
 			hasGamesToday = true
 
-			if game.StartTime.After(now) || isLive(game.Status) {
+			if game.StartTime.After(now) || game.State.IsLive() || awaitingStart(game) {
 				return false
 			}
 		}
@@ -94,13 +213,13 @@ func allGameFinishedforToday(games []api.Game) bool {
 
 func getFinishedGamesToday(games []api.Game) []api.Game {
 	var finishedGames []api.Game
-	now := time.Now()
+	now := clock()
 	todayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	todayEnd := todayStart.AddDate(0, 0, 1)
 
 	for _, game := range games {
 		if game.StartTime.After(todayStart) && game.StartTime.Before(todayEnd) {
-			if isFinished(game.Status) {
+			if game.State.IsFinished() {
 				finishedGames = append(finishedGames, game)
 			}
 		}
@@ -113,27 +232,21 @@ func getFinishedGamesToday(games []api.Game) []api.Game {
 	return finishedGames
 }
 
-func isFinished(status string) bool {
-	return status == "Final" || status == "STATUS_FINAL" ||
-		status == "Final/OT" || status == "STATUS_FINAL_OT" ||
-		status == "Final/2OT" || status == "Final/3OT" ||
-		status == "Postponed" || status == "STATUS_POSTPONED" ||
-		status == "Canceled" || status == "STATUS_CANCELED"
-}
-	
 func isUpcoming(startTime time.Time, duration time.Duration) bool {
-	now := time.Now()
+	now := clock()
 	return startTime.After(now) && startTime.Before(now.Add(duration))
 }
 
-func isLive(status string) bool {
-	return status == "STATUS_IN_PROGRESS" || status == "In Progress" || status == "STATUS_HALFTIME" || status == "Halftime" || status == "End of Period"
+// Still listed as scheduled after its start time, e.g. held up before the
+// first pitch without ESPN calling it a delay yet
+func awaitingStart(game api.Game) bool {
+	return game.State == api.Scheduled && !game.StartTime.After(clock())
 }
 
 func countLiveGames(games []api.Game) int {
 	count := 0
 	for _, game := range games {
-		if isLive(game.Status) {
+		if game.State.IsLive() {
 			count++
 		}
 	}
@@ -1,16 +1,36 @@
 package config
 
 import (
+	"unicode"
+
 	"github.com/gdamore/tcell/v2"
+	"github.com/mcbk51/scores_dash/api"
 )
 
 
-func NewInputHandler(scroller *Scroller, display *Display, quit func()) func(event *tcell.EventKey) *tcell.EventKey {
+func NewInputHandler(scroller *Scroller, display *Display, showStandings func(), showBooks func(), showLines func(), showDetail func(api.Game), quit func()) func(event *tcell.EventKey) *tcell.EventKey {
 	return func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Key() {
 		case tcell.KeyCtrlC, tcell.KeyEscape:
 			quit()
 			return nil
+		case tcell.KeyTab:
+			scroller.FocusNext()
+			return nil
+		case tcell.KeyBacktab:
+			scroller.FocusPrev()
+			return nil
+		case tcell.KeyDown:
+			display.MoveSelection(1)
+			return nil
+		case tcell.KeyUp:
+			display.MoveSelection(-1)
+			return nil
+		case tcell.KeyEnter:
+			if game, ok := display.Selected(); ok {
+				showDetail(game)
+			}
+			return nil
 		}
 
 		switch event.Rune() {
@@ -39,8 +59,57 @@ func NewInputHandler(scroller *Scroller, display *Display, quit func()) func(eve
 		case 'k':
 			scroller.ScrollUp()
 			return nil
+		case 'a', 'A':
+			display.ToggleFavorite(false)
+			go display.MainOutput()
+			return nil
+		case 'h', 'H':
+			display.ToggleFavorite(true)
+			go display.MainOutput()
+			return nil
+		case 'f', 'F':
+			display.ToggleFavoritesOnly()
+			go display.MainOutput()
+			return nil
+		case 't', 'T':
+			showStandings()
+			return nil
+		case 'o', 'O':
+			showBooks()
+			return nil
+		case 'm', 'M':
+			showLines()
+			return nil
 		}
 		return event
 	}
 }
 
+// Input for read-only pages that go back on Esc, q or the key that opened them
+func NewPageInputHandler(key rune, back func()) func(event *tcell.EventKey) *tcell.EventKey {
+	return func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			back()
+			return nil
+		}
+
+		switch event.Rune() {
+		case key, unicode.ToUpper(key), 'q':
+			back()
+			return nil
+		}
+		return event
+	}
+}
+
+// Input for the game detail modal, which closes on Enter as well
+func NewDetailInputHandler(back func()) func(event *tcell.EventKey) *tcell.EventKey {
+	pageInput := NewPageInputHandler('q', back)
+	return func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEnter {
+			back()
+			return nil
+		}
+		return pageInput(event)
+	}
+}
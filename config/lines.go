@@ -0,0 +1,85 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/mcbk51/scores_dash/api"
+	"github.com/rivo/tview"
+)
+
+// Page listing every line seen for each open game since the dashboard started
+type LinesView struct {
+	app     *tview.Application
+	view    *tview.TextView
+	display *Display
+}
+
+func NewLinesView(app *tview.Application, view *tview.TextView, display *Display) *LinesView {
+	return &LinesView{
+		app:     app,
+		view:    view,
+		display: display,
+	}
+}
+
+// Renders the line history of live and upcoming games from the last
+// refresh, including idle leagues' next games
+func (l *LinesView) Output() {
+	l.view.Clear()
+	fmt.Fprintf(l.view, "[yellow]=== Line Movement ===[-] [gray]m back[-]\n\n")
+
+	shown := 0
+	for _, game := range l.display.Games() {
+		if game.State.IsFinished() || len(game.LineHistory) == 0 {
+			continue
+		}
+		renderLineHistory(l.view, game)
+		shown++
+	}
+	if shown == 0 {
+		fmt.Fprintf(l.view, "[gray]No lines available[-]\n")
+	}
+	l.view.ScrollToBeginning()
+	l.app.Draw()
+}
+
+func renderLineHistory(view *tview.TextView, game api.Game) {
+	hasDraw := false
+	width := len("Book")
+	for _, snap := range game.LineHistory {
+		width = max(width, len(snap.Provider))
+		hasDraw = hasDraw || snap.DrawMoneyLine != 0
+	}
+
+	fmt.Fprintf(view, "[%s]▼ %s[-] %s @ %s\n", leagueColor(game.League), game.League, game.AwayTeam, game.HomeTeam)
+
+	header := fmt.Sprintf("   %5s %8s %-*s %6s %6s %6s %8s %8s", "", "Time", width, "Book", "Away", "Home", "Total", "Away ML", "Home ML")
+	if hasDraw {
+		header += fmt.Sprintf(" %8s", "Draw")
+	}
+	fmt.Fprintf(view, "[gray]%s[-]\n", header)
+
+	for i, snap := range game.LineHistory {
+		label := ""
+		switch {
+		case i == 0:
+			label = "open"
+		case i == len(game.LineHistory)-1:
+			label = "now"
+		}
+		row := fmt.Sprintf("   %5s %8s %-*s %6s %6s %6s %8s %8s",
+			label,
+			snap.Time.Local().Format("3:04 PM"),
+			width, snap.Provider,
+			formatLine(snap.HasSpread(), snap.AwaySpread, 0, "%+.1f"),
+			formatLine(snap.HasSpread(), snap.HomeSpread, 0, "%+.1f"),
+			formatLine(snap.HasTotal(), snap.Total, 0, "%.1f"),
+			formatMoneyLine(snap.AwayMoneyLine),
+			formatMoneyLine(snap.HomeMoneyLine))
+		if hasDraw {
+			row += fmt.Sprintf(" %8s", formatMoneyLine(snap.DrawMoneyLine))
+		}
+		fmt.Fprintf(view, "%s\n", row)
+	}
+	fmt.Fprintf(view, "\n")
+}
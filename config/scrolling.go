@@ -5,28 +5,93 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
 
+// Scrolls the dashboard's panels. Auto-scroll moves every panel at once,
+// manual scrolling moves the focused one.
 type Scroller struct {
 	mu        sync.Mutex
 	enabled   bool
 	speed     time.Duration
 	direction int
-	view      *tview.TextView
+	views     []*tview.TextView
+	focused   int
 	app       *tview.Application
+
+	// Called with the focused panel's index whenever focus is set
+	focusChanged func(index int)
 }
 
-func NewScroller(app *tview.Application, view *tview.TextView) *Scroller {
+func NewScroller(app *tview.Application) *Scroller {
 	return &Scroller{
 		enabled:   false,
 		speed:     time.Millisecond * 3500,
 		direction: 1,
-		view:      view,
 		app:       app,
 	}
 }
 
+// Replaces the panels being scrolled, keeping focus on the same position
+func (s *Scroller) SetViews(views []*tview.TextView) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.views = views
+	s.focused = max(0, min(s.focused, len(views)-1))
+	s.markFocus()
+}
+
+// Sets the function called when the focused panel changes, so the panel
+// can be brought into view. It runs with the scroller locked.
+func (s *Scroller) SetFocusChangedFunc(handler func(index int)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.focusChanged = handler
+}
+
+func (s *Scroller) FocusNext() {
+	s.moveFocus(1)
+}
+
+func (s *Scroller) FocusPrev() {
+	s.moveFocus(-1)
+}
+
+func (s *Scroller) moveFocus(delta int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.views) == 0 {
+		return
+	}
+	s.focused = (s.focused + delta + len(s.views)) % len(s.views)
+	s.markFocus()
+}
+
+// Highlights the focused panel's border, callers hold the lock
+func (s *Scroller) markFocus() {
+	for i, view := range s.views {
+		color := tcell.ColorGray
+		if i == s.focused {
+			color = tcell.ColorYellow
+		}
+		view.SetBorderColor(color)
+	}
+	if s.focusChanged != nil && len(s.views) > 0 {
+		s.focusChanged(s.focused)
+	}
+}
+
+// Panel manual scrolling applies to, nil when there are none
+func (s *Scroller) Focused() *tview.TextView {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.views) == 0 {
+		return nil
+	}
+	return s.views[s.focused]
+}
+
 func (s *Scroller) Toggle() {
 	s.mu.Lock()
 	s.enabled = !s.enabled
@@ -68,15 +133,23 @@ func (s *Scroller) GetSpeed() time.Duration {
 }
 
 func (s *Scroller) ScrollUp() {
-	row, col := s.view.GetScrollOffset()
+	view := s.Focused()
+	if view == nil {
+		return
+	}
+	row, col := view.GetScrollOffset()
 	if row > 0 {
-		s.view.ScrollTo(row-1, col)
+		view.ScrollTo(row-1, col)
 	}
 }
 
 func (s *Scroller) ScrollDown() {
-	row, col := s.view.GetScrollOffset()
-	s.view.ScrollTo(row+1, col)
+	view := s.Focused()
+	if view == nil {
+		return
+	}
+	row, col := view.GetScrollOffset()
+	view.ScrollTo(row+1, col)
 }
 
 func (s *Scroller) Start(ctx context.Context, quitChan chan bool) {
@@ -92,31 +165,14 @@ func (s *Scroller) Start(ctx context.Context, quitChan chan bool) {
 				enabled := s.enabled
 				speed := s.speed
 				dir := s.direction
+				views := s.views
 				s.mu.Unlock()
 
 				if enabled {
 					s.app.QueueUpdateDraw(func() {
-						row, col := s.view.GetScrollOffset()
-						_, _, _, viewHeight := s.view.GetInnerRect()
-
-						text := s.view.GetText(false)
-						totalLines := 1
-						for _, c := range text {
-							if c == '\n' {
-								totalLines++
-							}
-						}
-
-						maxScroll := max(totalLines-viewHeight)
-						newRow := row + dir
-
-						if dir > 0 && newRow >= maxScroll {
-							newRow = 0
-						}else if dir < 0 && newRow <= 0 {
-							newRow = maxScroll
+						for _, view := range views {
+							scrollView(view, dir)
 						}
-
-						s.view.ScrollTo(newRow, col)
 					})
 				}
 				time.Sleep(speed)
@@ -125,6 +181,35 @@ func (s *Scroller) Start(ctx context.Context, quitChan chan bool) {
 	}()
 }
 
+// Moves a panel one line, wrapping around at either end. Panels whose
+// content fits are left alone.
+func scrollView(view *tview.TextView, dir int) {
+	row, col := view.GetScrollOffset()
+	_, _, _, viewHeight := view.GetInnerRect()
+
+	text := view.GetText(false)
+	totalLines := 1
+	for _, c := range text {
+		if c == '\n' {
+			totalLines++
+		}
+	}
+
+	maxScroll := totalLines-viewHeight
+	if maxScroll <= 0 {
+		return
+	}
+	newRow := row + dir
+
+	if dir > 0 && newRow >= maxScroll {
+		newRow = 0
+	}else if dir < 0 && newRow <= 0 {
+		newRow = maxScroll
+	}
+
+	view.ScrollTo(newRow, col)
+}
+
 func (s *Scroller) StatusString() string {
 	s.mu.Lock()
  	defer s.mu.Unlock()
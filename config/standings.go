@@ -0,0 +1,133 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/mcbk51/scores_dash/api"
+	"github.com/rivo/tview"
+)
+
+// Page showing one league's standings at a time
+type StandingsView struct {
+	app      *tview.Application
+	view     *tview.TextView
+	provider api.StandingsProvider
+	ctx      context.Context
+
+	mu      sync.Mutex
+	leagues []api.League
+	index   int
+}
+
+func NewStandingsView(app *tview.Application, view *tview.TextView, provider api.StandingsProvider, ctx context.Context) *StandingsView {
+	return &StandingsView{
+		app:      app,
+		view:     view,
+		provider: provider,
+		ctx:      ctx,
+		leagues:  api.Leagues(),
+	}
+}
+
+func (s *StandingsView) current() api.League {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.leagues[s.index]
+}
+
+func (s *StandingsView) step(delta int) {
+	s.mu.Lock()
+	s.index = (s.index + delta + len(s.leagues)) % len(s.leagues)
+	s.mu.Unlock()
+}
+
+func (s *StandingsView) Next() {
+	s.step(1)
+	go s.Output()
+}
+
+func (s *StandingsView) Prev() {
+	s.step(-1)
+	go s.Output()
+}
+
+// Fetches and renders the current league's standings
+func (s *StandingsView) Output() {
+	league := s.current()
+
+	ctx, cancel := context.WithTimeout(s.ctx, refreshTimeout)
+	defer cancel()
+
+	standings, err := s.provider.GetStandings(ctx, league.ID)
+	if s.ctx.Err() != nil || s.current().ID != league.ID {
+		return
+	}
+
+	s.view.Clear()
+	fmt.Fprintf(s.view, "[yellow]=== %s Standings ===[-] [gray]←/→ league | t back[-]\n\n", league.Name)
+	if err != nil {
+		fmt.Fprintf(s.view, "[red]Error fetching standings: %v[-]\n", err)
+		s.app.Draw()
+		return
+	}
+	if len(standings.Groups) == 0 {
+		fmt.Fprintf(s.view, "[gray]No standings available[-]\n")
+	}
+
+	for _, group := range standings.Groups {
+		renderStandingsGroup(s.view, league.Color, group)
+	}
+	s.view.ScrollToBeginning()
+	s.app.Draw()
+}
+
+func renderStandingsGroup(view *tview.TextView, color string, group api.StandingsGroup) {
+	width := len("Team")
+	for _, entry := range group.Entries {
+		width = max(width, len(entry.Team))
+	}
+
+	fmt.Fprintf(view, "[%s]▼ %s[-]\n", color, group.Name)
+	fmt.Fprintf(view, "[gray]   %-*s %8s %6s %5s %5s[-]\n", width, "Team", "W-L", "PCT", "GB", "STRK")
+	for _, entry := range group.Entries {
+		clinch := "  "
+		if entry.Clincher != "" {
+			clinch = fmt.Sprintf("[green]%-2s[-]", entry.Clincher)
+		}
+		fmt.Fprintf(view, " %s%-*s %8s %6s %5s %5s\n",
+			clinch, width, entry.Team, entry.Record(), api.FormatWinPct(entry.WinPct), entry.GamesBehind, entry.Streak)
+	}
+	fmt.Fprintf(view, "\n")
+}
+
+func NewStandingsInputHandler(standings *StandingsView, back func()) func(event *tcell.EventKey) *tcell.EventKey {
+	return func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			back()
+			return nil
+		case tcell.KeyRight:
+			standings.Next()
+			return nil
+		case tcell.KeyLeft:
+			standings.Prev()
+			return nil
+		}
+
+		switch event.Rune() {
+		case 't', 'T', 'q':
+			back()
+			return nil
+		case 'l':
+			standings.Next()
+			return nil
+		case 'h':
+			standings.Prev()
+			return nil
+		}
+		return event
+	}
+}
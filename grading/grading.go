@@ -0,0 +1,120 @@
+// Package grading settles spread, moneyline and total bets against a game's
+// score, either for good once it is final or as a live "currently covering"
+// read while it is being played.
+package grading
+
+import "github.com/mcbk51/scores_dash/api"
+
+// Outcome of one side of a bet
+type Result int
+
+const (
+	// No line for the market, or the game can't be graded yet
+	NoAction Result = iota
+	Win
+	Loss
+	Push
+)
+
+var resultNames = map[Result]string{
+	NoAction: "No Action",
+	Win:      "Win",
+	Loss:     "Loss",
+	Push:     "Push",
+}
+
+func (r Result) String() string {
+	if name, ok := resultNames[r]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// Results for each side of every market on a game's line
+type Grades struct {
+	HomeSpread    Result
+	AwaySpread    Result
+	HomeMoneyLine Result
+	AwayMoneyLine Result
+	DrawMoneyLine Result
+	Over          Result
+	Under         Result
+}
+
+// Settles every market against the final score. Games that aren't final,
+// including postponed and canceled ones, grade as NoAction.
+func Grade(game api.Game) Grades {
+	if game.State != api.Final {
+		return Grades{}
+	}
+	return grade(game)
+}
+
+// Grades the line as if the game ended at the current score, for showing
+// who is covering while it is live. Games not in play grade as NoAction.
+func Covering(game api.Game) Grades {
+	if !game.State.IsLive() {
+		return Grades{}
+	}
+	return grade(game)
+}
+
+func grade(game api.Game) Grades {
+	odds := game.Odds
+	var grades Grades
+
+	if odds.HasSpread() {
+		grades.HomeSpread = compare(float64(game.HomeScore-game.AwayScore) + odds.HomeSpread)
+		grades.AwaySpread = compare(float64(game.AwayScore-game.HomeScore) + odds.AwaySpread)
+	}
+
+	if odds.HasTotal() {
+		grades.Over = compare(float64(game.HomeScore+game.AwayScore) - odds.Total)
+		grades.Under = opposite(grades.Over)
+	}
+
+	// Shootouts don't count, so a level score is a draw in three-way markets
+	// and a push on a two-way moneyline
+	margin := compare(float64(game.HomeScore - game.AwayScore))
+	threeWay := odds.DrawMoneyLine != 0
+	if odds.HomeMoneyLine != 0 {
+		grades.HomeMoneyLine = moneyLine(margin, threeWay)
+	}
+	if odds.AwayMoneyLine != 0 {
+		grades.AwayMoneyLine = moneyLine(opposite(margin), threeWay)
+	}
+	if threeWay {
+		grades.DrawMoneyLine = Loss
+		if margin == Push {
+			grades.DrawMoneyLine = Win
+		}
+	}
+	return grades
+}
+
+func compare(margin float64) Result {
+	switch {
+	case margin > 0:
+		return Win
+	case margin < 0:
+		return Loss
+	}
+	return Push
+}
+
+func opposite(r Result) Result {
+	switch r {
+	case Win:
+		return Loss
+	case Loss:
+		return Win
+	}
+	return r
+}
+
+func moneyLine(margin Result, threeWay bool) Result {
+	if margin == Push && threeWay {
+		return Loss
+	}
+	return margin
+}
@@ -0,0 +1,143 @@
+package grading
+
+import (
+	"testing"
+
+	"github.com/mcbk51/scores_dash/api"
+)
+
+func final(away, home int, odds api.Odds) api.Game {
+	return api.Game{State: api.Final, AwayScore: away, HomeScore: home, Odds: odds}
+}
+
+func spread(home float64) api.Odds {
+	return api.Odds{HomeSpread: home, AwaySpread: -home}
+}
+
+func TestGradeSpread(t *testing.T) {
+	tests := []struct {
+		name string
+		game api.Game
+		home Result
+		away Result
+	}{
+		{"favorite covers", final(20, 27, spread(-3.5)), Win, Loss},
+		{"favorite wins but doesn't cover", final(24, 27, spread(-3.5)), Loss, Win},
+		{"underdog loses but covers", final(20, 17, spread(3.5)), Win, Loss},
+		{"underdog wins outright", final(17, 20, spread(3.5)), Win, Loss},
+		{"underdog loses and doesn't cover", final(24, 17, spread(3.5)), Loss, Win},
+		{"favorite wins by the number", final(24, 27, spread(-3)), Push, Push},
+		{"underdog loses by the number", final(27, 24, spread(3)), Push, Push},
+		{"half point on a tie", final(2, 2, spread(-0.5)), Loss, Win},
+		{"away favorite covers", final(31, 14, spread(7)), Loss, Win},
+		{"no spread", final(20, 27, api.Odds{}), NoAction, NoAction},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Grade(tt.game)
+			if got.HomeSpread != tt.home || got.AwaySpread != tt.away {
+				t.Errorf("home %v away %v, want home %v away %v", got.HomeSpread, got.AwaySpread, tt.home, tt.away)
+			}
+		})
+	}
+}
+
+func TestGradeMoneyLine(t *testing.T) {
+	twoWay := api.Odds{HomeMoneyLine: -175, AwayMoneyLine: 150}
+	threeWay := api.Odds{HomeMoneyLine: -120, AwayMoneyLine: 290, DrawMoneyLine: 260}
+
+	tests := []struct {
+		name string
+		game api.Game
+		home Result
+		away Result
+		draw Result
+	}{
+		{"home wins", final(20, 27, twoWay), Win, Loss, NoAction},
+		{"away wins", final(27, 20, twoWay), Loss, Win, NoAction},
+		{"tie pushes a two-way line", final(20, 20, twoWay), Push, Push, NoAction},
+		{"home wins three-way", final(0, 1, threeWay), Win, Loss, Loss},
+		{"away wins three-way", final(2, 1, threeWay), Loss, Win, Loss},
+		{"draw three-way", final(1, 1, threeWay), Loss, Loss, Win},
+		{"home price only", final(1, 3, api.Odds{HomeMoneyLine: -150}), Win, NoAction, NoAction},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Grade(tt.game)
+			if got.HomeMoneyLine != tt.home || got.AwayMoneyLine != tt.away || got.DrawMoneyLine != tt.draw {
+				t.Errorf("home %v away %v draw %v, want home %v away %v draw %v",
+					got.HomeMoneyLine, got.AwayMoneyLine, got.DrawMoneyLine, tt.home, tt.away, tt.draw)
+			}
+		})
+	}
+}
+
+func TestGradeShootoutIsADraw(t *testing.T) {
+	game := final(1, 1, api.Odds{HomeMoneyLine: 140, AwayMoneyLine: 175, DrawMoneyLine: 240})
+	game.AwayShootout, game.HomeShootout = 4, 3
+
+	got := Grade(game)
+	if got.DrawMoneyLine != Win || got.AwayMoneyLine != Loss {
+		t.Errorf("draw %v away %v, want draw Win away Loss", got.DrawMoneyLine, got.AwayMoneyLine)
+	}
+}
+
+func TestGradeTotal(t *testing.T) {
+	tests := []struct {
+		name  string
+		game  api.Game
+		over  Result
+		under Result
+	}{
+		{"over", final(24, 27, api.Odds{Total: 44.5}), Win, Loss},
+		{"under", final(10, 13, api.Odds{Total: 44.5}), Loss, Win},
+		{"push on a whole number", final(20, 24, api.Odds{Total: 44}), Push, Push},
+		{"no total", final(20, 24, api.Odds{}), NoAction, NoAction},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Grade(tt.game)
+			if got.Over != tt.over || got.Under != tt.under {
+				t.Errorf("over %v under %v, want over %v under %v", got.Over, got.Under, tt.over, tt.under)
+			}
+		})
+	}
+}
+
+func TestGradeOnlyFinalGames(t *testing.T) {
+	odds := api.Odds{HomeSpread: -3.5, AwaySpread: 3.5, HomeMoneyLine: -175, AwayMoneyLine: 150, Total: 44.5}
+	for _, state := range []api.GameState{api.Scheduled, api.InProgress, api.Halftime, api.Delayed, api.Suspended, api.Postponed, api.Canceled} {
+		t.Run(state.String(), func(t *testing.T) {
+			game := api.Game{State: state, AwayScore: 20, HomeScore: 27, Odds: odds}
+			if got := Grade(game); got != (Grades{}) {
+				t.Errorf("got %+v, want no action", got)
+			}
+		})
+	}
+}
+
+func TestCovering(t *testing.T) {
+	odds := api.Odds{HomeSpread: -7.5, AwaySpread: 7.5, Total: 47.5}
+
+	tests := []struct {
+		name  string
+		state api.GameState
+		away  int
+		home  int
+		want  Grades
+	}{
+		{"favorite covering", api.InProgress, 10, 21, Grades{HomeSpread: Win, AwaySpread: Loss, Over: Loss, Under: Win}},
+		{"underdog covering at the half", api.Halftime, 14, 17, Grades{HomeSpread: Loss, AwaySpread: Win, Over: Loss, Under: Win}},
+		{"total already over", api.EndOfPeriod, 24, 28, Grades{HomeSpread: Loss, AwaySpread: Win, Over: Win, Under: Loss}},
+		{"not started", api.Scheduled, 0, 0, Grades{}},
+		{"final isn't live", api.Final, 10, 21, Grades{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := api.Game{State: tt.state, AwayScore: tt.away, HomeScore: tt.home, Odds: odds}
+			if got := Covering(game); got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"net/http"
 	"time"
 	"os"
 	"os/signal"
@@ -10,18 +12,84 @@ import (
 
 	"github.com/rivo/tview"
 	"github.com/gdamore/tcell/v2"
+	"github.com/mcbk51/scores_dash/api"
 	"github.com/mcbk51/scores_dash/config"
+	"github.com/mcbk51/scores_dash/mockserver"
 )
 
 func main (){
+	if len(os.Args) > 1 && os.Args[1] == "mockserver" {
+		if err := mockserver.Run(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	siteURL := flag.String("site-url", api.DefaultSiteURL, "base URL for scoreboard requests")
+	coreURL := flag.String("core-url", api.DefaultCoreURL, "base URL for odds requests")
+	recordDir := flag.String("record", "", "save every API response to this directory")
+	replayDir := flag.String("replay", "", "replay API responses recorded with --record from this directory")
+	replaySpeed := flag.Float64("replay-speed", 1, "playback speed multiplier for --replay")
+	books := flag.String("books", api.FormatOddsProviders(api.DefaultOddsProviders), "odds providers in order of preference")
+	favoritesPath := flag.String("favorites", config.DefaultFavoritesPath(), "file favorite teams are read from and saved to")
+	flag.Parse()
+
+	oddsProviders, err := api.ParseOddsProviders(*books)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	favorites, err := config.LoadFavorites(*favoritesPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *recordDir != "" && *replayDir != "" {
+		fmt.Fprintln(os.Stderr, "--record and --replay can't be used together")
+		os.Exit(1)
+	}
+
+	client := &http.Client{}
+	now := time.Now
+	refreshInterval := 30 * time.Second
+	switch {
+	case *replayDir != "":
+		replayer, err := api.NewReplayer(*replayDir, *replaySpeed)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		client.Transport = replayer
+		now = replayer.Now
+		// Refresh as often on the recorded timeline as live, within reason
+		refreshInterval = max(replayer.Scale(refreshInterval), time.Second)
+		config.SetClock(now)
+	case *recordDir != "":
+		recorder, err := api.NewRecorder(*recordDir, http.DefaultTransport)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		defer recorder.Close()
+		client.Transport = recorder
+	}
+
 	app := tview.NewApplication()
 
 	tview.Styles.PrimitiveBackgroundColor = tcell.ColorDefault
 	tview.Styles.ContrastBackgroundColor = tcell.ColorDefault
 	
-	scoreview := tview.NewTextView().
-		SetDynamicColors(true).
-		SetScrollable(true)
+	// Scoreboard: a header above one panel per league
+	header := tview.NewTextView().
+		SetDynamicColors(true)
+	grid := tview.NewGrid()
+	dashboard := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(header, 2, 0, false).
+		AddItem(grid, 0, 1, true)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -33,21 +101,22 @@ func main (){
 		default:
 		}
 		cancel()
-
-		go func() {
-			time.Sleep(time.Millisecond * 30)
-			fmt.Println("\nQuitting...")
-			os.Exit(0)
-		}()
 		app.Stop()
 	}
 
 	// Scrolling
-	scroller := config.NewScroller(app, scoreview)
+	scroller := config.NewScroller(app)
 	scroller.Start(ctx, quitChan)
 
 	// Main output setup
-	display := config.NewDisplay(app, scoreview, scroller, ctx, quitChan)
+	provider := api.NewESPNProvider(api.ESPNConfig{
+		SiteURL: *siteURL,
+		CoreURL: *coreURL,
+		Client:  client,
+		OddsProviders: oddsProviders,
+		Clock:   now,
+	})
+	display := config.NewDisplay(app, header, grid, scroller, provider, favorites, ctx, quitChan)
 
 	// Handle signals
 	signalChan := make(chan os.Signal, 1)
@@ -57,16 +126,89 @@ func main (){
 		quit()
 	}()
 
+	// Standings page
+	standingsview := tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true)
+	standings := config.NewStandingsView(app, standingsview, provider, ctx)
+
+	// Odds comparison page
+	booksview := tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true)
+	bookComparison := config.NewBooksView(app, booksview, display)
+
+	// Line movement page
+	linesview := tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true)
+	lineHistory := config.NewLinesView(app, linesview, display)
+
+	// Game detail modal, centered over the scoreboard
+	detailview := tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true)
+	detailview.SetBorder(true).SetTitleAlign(tview.AlignLeft)
+	detail := config.NewDetailView(app, detailview, provider, ctx)
+	detailModal := tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().
+			SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(detailview, 0, 4, true).
+			AddItem(nil, 0, 1, false), 0, 3, true).
+		AddItem(nil, 0, 1, false)
+
+	pages := tview.NewPages().
+		AddPage("scores", dashboard, true, true).
+		AddPage("standings", standingsview, true, false).
+		AddPage("books", booksview, true, false).
+		AddPage("lines", linesview, true, false).
+		AddPage("detail", detailModal, true, false)
+
+	showStandings := func() {
+		pages.SwitchToPage("standings")
+		go standings.Output()
+	}
+	showBooks := func() {
+		pages.SwitchToPage("books")
+		go bookComparison.Output()
+	}
+	showLines := func() {
+		pages.SwitchToPage("lines")
+		go lineHistory.Output()
+	}
+	showDetail := func(game api.Game) {
+		pages.ShowPage("detail")
+		go detail.Output(game)
+	}
+	hideDetail := func() {
+		pages.HidePage("detail")
+	}
+	showScores := func() {
+		pages.SwitchToPage("scores")
+	}
+
 	// Input handler
-	scoreview.SetInputCapture(config.NewInputHandler(scroller, display, quit))
+	dashboard.SetInputCapture(config.NewInputHandler(scroller, display, showStandings, showBooks, showLines, showDetail, quit))
+	standingsview.SetInputCapture(config.NewStandingsInputHandler(standings, showScores))
+	booksview.SetInputCapture(config.NewPageInputHandler('o', showScores))
+	linesview.SetInputCapture(config.NewPageInputHandler('m', showScores))
+	detailview.SetInputCapture(config.NewDetailInputHandler(hideDetail))
 
 	// Initial Load
 	go display.MainOutput()
 
 	// Refresh ticker
-	display.StartTicker(time.Second * 30)
+	display.StartTicker(refreshInterval)
+
+	// Reflow the league panels when the terminal is resized
+	app.SetAfterDrawFunc(func(screen tcell.Screen) {
+		display.CheckWidth()
+	})
 
-	if err := app.SetRoot(scoreview, true).Run(); err != nil {
-		os.Exit(0)
+	if err := app.SetRoot(pages, true).Run(); err != nil {
+		os.Exit(1)
 	}
+	fmt.Println("Quitting...")
 }
@@ -0,0 +1,171 @@
+// Package mockserver serves canned ESPN scoreboard and odds responses so the
+// dashboard can be developed, demoed and tested without network access.
+package mockserver
+
+import (
+	"crypto/sha1"
+	"embed"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+//go:embed fixtures
+var bundled embed.FS
+
+// Matches {{time:<duration>}} placeholders in fixture files. They are
+// replaced with the requested date at the current time of day shifted by the
+// duration, so fixtures stay "live" whenever they are served.
+var timePlaceholder = regexp.MustCompile(`\{\{time:([-+0-9a-z.]*)\}\}`)
+
+type Server struct {
+	fixtures fs.FS
+	mux      *http.ServeMux
+}
+
+// Creates a server reading fixtures laid out as
+//
+//	scoreboard/<league>.json            served for every date
+//	scoreboard/<league>-<YYYYMMDD>.json served for one date, if present
+//	odds/<eventID>.json                 odds for one event
+//	odds/default.json                   odds for any other event
+//	summary/<eventID>.json              box score and leaders for one event
+//	plays/<eventID>.json                play-by-play for one event
+//	standings/<league>.json             standings for a league
+//	schedule/<league>-<teamID>.json     season schedule for a team
+//	teams/<league>.json                 every team in a league
+//	injuries/<league>.json              injury report for a league
+func New(fixtures fs.FS) *Server {
+	s := &Server{fixtures: fixtures, mux: http.NewServeMux()}
+	s.mux.HandleFunc("GET /apis/site/v2/sports/{sport}/{league}/scoreboard", s.handleScoreboard)
+	s.mux.HandleFunc("GET /apis/site/v2/sports/{sport}/{league}/summary", s.handleSummary)
+	s.mux.HandleFunc("GET /apis/v2/sports/{sport}/{league}/standings", s.handleStandings)
+	s.mux.HandleFunc("GET /apis/site/v2/sports/{sport}/{league}/teams", s.handleTeams)
+	s.mux.HandleFunc("GET /apis/site/v2/sports/{sport}/{league}/teams/{team}/schedule", s.handleSchedule)
+	s.mux.HandleFunc("GET /apis/site/v2/sports/{sport}/{league}/injuries", s.handleInjuries)
+	s.mux.HandleFunc("GET /v2/sports/{sport}/leagues/{league}/events/{event}/competitions/{comp}/odds", s.handleOdds)
+	s.mux.HandleFunc("GET /v2/sports/{sport}/leagues/{league}/events/{event}/competitions/{comp}/plays", s.handlePlays)
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log.Printf("%s %s", r.Method, r.URL.RequestURI())
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) handleScoreboard(w http.ResponseWriter, r *http.Request) {
+	league := r.PathValue("league")
+	date := time.Now()
+	if d := r.URL.Query().Get("dates"); d != "" {
+		// Ranges are served the fixture for their first day
+		d, _, _ = strings.Cut(d, "-")
+		parsed, err := time.ParseInLocation("20060102", d, time.Local)
+		if err != nil {
+			http.Error(w, "bad dates parameter", http.StatusBadRequest)
+			return
+		}
+		date = parsed
+	}
+
+	s.serveFixture(w, r, date,
+		fmt.Sprintf("scoreboard/%s-%s.json", league, date.Format("20060102")),
+		fmt.Sprintf("scoreboard/%s.json", league))
+}
+
+func (s *Server) handleOdds(w http.ResponseWriter, r *http.Request) {
+	s.serveFixture(w, r, time.Now(),
+		fmt.Sprintf("odds/%s.json", r.PathValue("event")),
+		"odds/default.json")
+}
+
+func (s *Server) handlePlays(w http.ResponseWriter, r *http.Request) {
+	s.serveFixture(w, r, time.Now(),
+		fmt.Sprintf("plays/%s.json", r.PathValue("event")))
+}
+
+func (s *Server) handleStandings(w http.ResponseWriter, r *http.Request) {
+	s.serveFixture(w, r, time.Now(),
+		fmt.Sprintf("standings/%s.json", r.PathValue("league")))
+}
+
+func (s *Server) handleSchedule(w http.ResponseWriter, r *http.Request) {
+	s.serveFixture(w, r, time.Now(),
+		fmt.Sprintf("schedule/%s-%s.json", r.PathValue("league"), r.PathValue("team")))
+}
+
+func (s *Server) handleTeams(w http.ResponseWriter, r *http.Request) {
+	s.serveFixture(w, r, time.Now(),
+		fmt.Sprintf("teams/%s.json", r.PathValue("league")))
+}
+
+func (s *Server) handleInjuries(w http.ResponseWriter, r *http.Request) {
+	s.serveFixture(w, r, time.Now(),
+		fmt.Sprintf("injuries/%s.json", r.PathValue("league")))
+}
+
+func (s *Server) handleSummary(w http.ResponseWriter, r *http.Request) {
+	s.serveFixture(w, r, time.Now(),
+		fmt.Sprintf("summary/%s.json", r.URL.Query().Get("event")))
+}
+
+// Serves the first fixture that exists, expanding time placeholders against date
+func (s *Server) serveFixture(w http.ResponseWriter, r *http.Request, date time.Time, names ...string) {
+	for _, name := range names {
+		data, err := fs.ReadFile(s.fixtures, name)
+		if err != nil {
+			continue
+		}
+		body := expandTimes(data, date)
+		etag := fmt.Sprintf(`"%x"`, sha1.Sum(body))
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		w.Write(body)
+		return
+	}
+	http.NotFound(w, r)
+}
+
+func expandTimes(data []byte, date time.Time) []byte {
+	now := time.Now()
+	base := time.Date(date.Year(), date.Month(), date.Day(), now.Hour(), now.Minute(), 0, 0, time.Local)
+
+	return timePlaceholder.ReplaceAllFunc(data, func(match []byte) []byte {
+		offset, err := time.ParseDuration(string(timePlaceholder.FindSubmatch(match)[1]))
+		if err != nil {
+			return match
+		}
+		return []byte(base.Add(offset).UTC().Format("2006-01-02T15:04Z"))
+	})
+}
+
+// Entry point for the `scores_dash mockserver` command
+func Run(args []string) error {
+	flags := flag.NewFlagSet("mockserver", flag.ExitOnError)
+	addr := flags.String("addr", "localhost:8080", "address to listen on")
+	dir := flags.String("fixtures", "", "directory of fixture files (defaults to the bundled set)")
+	flags.Parse(args)
+
+	var fixtures fs.FS
+	if *dir != "" {
+		fixtures = os.DirFS(*dir)
+	} else {
+		sub, err := fs.Sub(bundled, "fixtures")
+		if err != nil {
+			return err
+		}
+		fixtures = sub
+	}
+
+	log.Printf("mock ESPN server listening on http://%s", *addr)
+	return http.ListenAndServe(*addr, New(fixtures))
+}